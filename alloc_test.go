@@ -0,0 +1,29 @@
+//go:build !race
+
+package option
+
+import "testing"
+
+// 热路径上的操作不应产生堆分配，防止性能回退
+func TestAllocs(t *testing.T) {
+	o := Val(1)
+	cases := []struct {
+		name string
+		f    func()
+	}{
+		{"Val", func() { o = Val(2) }},
+		{"Nul", func() { o = Nul[int]() }},
+		{"Val(bool)", func() { _ = Val(true) }},
+		{"Val(large)", func() { _ = Val([8]int{}) }},
+		{"Get", func() { _ = Val(1).Get() }},
+		{"GetOr", func() { _ = o.GetOr(1) }},
+		{"Has", func() { _ = o.Has(1) }},
+		{"Map", func() { o = Map(o, func(v int) int { return v + 1 }) }},
+		{"Then", func() { o = Then(o, func(v int) Option[int] { return Val(v) }) }},
+	}
+	for _, c := range cases {
+		if n := testing.AllocsPerRun(100, c.f); n != 0 {
+			t.Errorf("%s: expected no allocations, got %v", c.name, n)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+// optionvet 检查被丢弃的 Option/Result 返回值，以及没有先检查状态就调用的 Get。
+//
+// 可以单独运行，也可以作为 go vet 的 vettool：
+//
+//	go install github.com/viocha/go-option/analysis/cmd/optionvet
+//	go vet -vettool=$(which optionvet) ./...
+package main
+
+import (
+	"github.com/viocha/go-option/analysis/resultcheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(resultcheck.Analyzer)
+}
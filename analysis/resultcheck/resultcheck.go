@@ -0,0 +1,114 @@
+package resultcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	optionPkg = "github.com/viocha/go-option"
+	resultPkg = "github.com/viocha/go-option/result"
+)
+
+// 检查被丢弃的 Option/Result 返回值，以及没有先检查状态就调用的 Get
+var Analyzer = &analysis.Analyzer{
+	Name:     "resultcheck",
+	Doc:      "report discarded Option/Result values and Get calls without a preceding IsVal/IsOk check",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// 调用这些方法后视为已经检查过状态
+var checkMethods = map[string]bool{"IsVal": true, "IsNul": true, "IsOk": true, "IsErr": true}
+
+// 这些方法为了副作用而调用，返回值只是接收者本身（或被取出的值），丢弃时不报告
+var sideEffectMethods = map[string]bool{
+	"Try": true, "Catch": true, "Inspect": true, "InspectErr": true, "InspectNul": true, "Finally": true,
+	"LogErr": true, "LogNone": true, "OnWarning": true, "OnFatal": true, "Take": true, "Replace": true,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	filter := []ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}
+	ins.Preorder(filter, func(n ast.Node) {
+		var body *ast.BlockStmt
+		switch fn := n.(type) {
+		case *ast.FuncDecl:
+			body = fn.Body
+		case *ast.FuncLit:
+			body = fn.Body
+		}
+		if body != nil {
+			checkFunc(pass, body)
+		}
+	})
+	return nil, nil
+}
+
+func checkFunc(pass *analysis.Pass, body *ast.BlockStmt) {
+	checked := make(map[types.Object]token.Pos) // 变量 -> 第一次检查状态的位置
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // 在 run 中单独检查
+		case *ast.ExprStmt:
+			if call, ok := n.X.(*ast.CallExpr); ok && isOptionOrResult(pass.TypesInfo.TypeOf(call)) && !isSideEffect(pass, call) {
+				pass.Reportf(call.Pos(), "result of %s is discarded", types.ExprString(call.Fun))
+			}
+		case *ast.CallExpr:
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			id, ok := sel.X.(*ast.Ident)
+			if !ok || !isOptionOrResult(pass.TypesInfo.TypeOf(id)) {
+				return true
+			}
+			obj := pass.TypesInfo.Uses[id]
+			switch {
+			case checkMethods[sel.Sel.Name]:
+				if _, ok := checked[obj]; !ok {
+					checked[obj] = n.Pos()
+				}
+			case sel.Sel.Name == "Get":
+				if _, ok := checked[obj]; !ok {
+					pass.Reportf(n.Pos(), "%s.Get() called without checking IsVal/IsOk first", id.Name)
+				}
+			}
+		}
+		return true
+	})
+}
+
+// call 是否为 Option/Result 上为了副作用而调用的方法
+func isSideEffect(pass *analysis.Pass, call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !sideEffectMethods[sel.Sel.Name] {
+		return false
+	}
+	t := pass.TypesInfo.TypeOf(sel.X)
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	return isOptionOrResult(t)
+}
+
+// t 是否为 Option 或 Result 的实例化类型
+func isOptionOrResult(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	switch named.Obj().Pkg().Path() {
+	case optionPkg:
+		return named.Obj().Name() == "Option"
+	case resultPkg:
+		return named.Obj().Name() == "Result"
+	}
+	return false
+}
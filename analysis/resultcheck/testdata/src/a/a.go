@@ -0,0 +1,52 @@
+package a
+
+import (
+	option "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+func load() result.Result[int] { return result.Ok(1) }
+
+func discarded() {
+	load()        // want `result of load is discarded`
+	option.Val(1) // want `result of option.Val is discarded`
+	_ = load()    // 显式丢弃不报告
+	r := load().Try(func(int) {})
+	_ = r
+}
+
+func sideEffects() {
+	// 为了副作用调用、返回接收者的方法不报告
+	load().Try(func(int) {}).Catch(func(error) {})
+	load().Inspect(func(int) {})
+	load().Err().Try(func(error) {})
+	o := option.Val(1)
+	o.Take()
+	load().Map(func(n int) int { return n }) // want `result of load\(\).Map is discarded`
+}
+
+func unchecked() int {
+	r := load()
+	return r.Get() // want `r.Get\(\) called without checking IsVal/IsOk first`
+}
+
+func checked() int {
+	r := load()
+	if !r.IsOk() {
+		return 0
+	}
+	o := option.Val(2)
+	if o.IsVal() {
+		return r.Get() + o.Get()
+	}
+	return r.Get()
+}
+
+func closure() {
+	o := option.Val(1)
+	if o.IsVal() {
+		func() {
+			_ = o.Get() // want `o.Get\(\) called without checking IsVal/IsOk first`
+		}()
+	}
+}
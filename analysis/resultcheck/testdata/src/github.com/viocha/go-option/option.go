@@ -0,0 +1,13 @@
+package option
+
+type Option[T any] struct {
+	val    T
+	exists bool
+}
+
+func Val[T any](v T) Option[T] { return Option[T]{val: v, exists: true} }
+
+func (o Option[T]) IsVal() bool             { return o.exists }
+func (o Option[T]) Get() T                  { return o.val }
+func (o Option[T]) Try(f func(T)) Option[T] { return o }
+func (o *Option[T]) Take() Option[T]        { return *o }
@@ -0,0 +1,18 @@
+package result
+
+import option "github.com/viocha/go-option"
+
+type Result[T any] struct {
+	val T
+	err error
+}
+
+func Ok[T any](v T) Result[T] { return Result[T]{val: v} }
+
+func (r Result[T]) IsOk() bool                    { return r.err == nil }
+func (r Result[T]) Get() T                        { return r.val }
+func (r Result[T]) Try(f func(T)) Result[T]       { return r }
+func (r Result[T]) Catch(f func(error)) Result[T] { return r }
+func (r Result[T]) Inspect(f func(T)) Result[T]   { return r }
+func (r Result[T]) Map(f func(T) T) Result[T]     { return r }
+func (r Result[T]) Err() option.Option[error]     { return option.Option[error]{} }
@@ -0,0 +1,60 @@
+package option
+
+import (
+	"sync"
+
+	"github.com/viocha/go-option/internal/common"
+)
+
+// ========================== 并发安全容器 =============================
+
+// 可以安全地并发读写的 Option，零值为 Nul，不能在使用后复制
+type Atomic[T any] struct {
+	mu sync.RWMutex
+	o  Option[T]
+}
+
+func (a *Atomic[T]) Load() Option[T] {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.o
+}
+
+func (a *Atomic[T]) Store(o Option[T]) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.o = o
+}
+
+// 设置为 o 并返回原来的值
+func (a *Atomic[T]) Swap(o Option[T]) Option[T] {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	old := a.o
+	a.o = o
+	return old
+}
+
+// 当前值与 old 相等（都为 Nul，或都存在值且值相等，比较语义同 Has）时设置为 new，返回是否设置成功
+func (a *Atomic[T]) CompareAndSwap(old, new Option[T]) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.o.IsVal() != old.IsVal() || (old.IsVal() && !common.Equal(a.o.val, old.val)) {
+		return false
+	}
+	a.o = new
+	return true
+}
+
+// 存在值时直接返回，否则调用 f 并将其结果保存后返回。并发调用时 f 最多被调用一次（直到其返回 Nul）
+func (a *Atomic[T]) LoadOrStoreFunc(f func() Option[T]) Option[T] {
+	if o := a.Load(); o.IsVal() {
+		return o
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.o.IsNul() {
+		a.o = f()
+	}
+	return a.o
+}
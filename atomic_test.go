@@ -0,0 +1,50 @@
+package option
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAtomic(t *testing.T) {
+	var a Atomic[string]
+	if a.Load().IsVal() {
+		t.Error("Expected the zero value to be None")
+	}
+	a.Store(Val("v1"))
+	if old := a.Swap(Val("v2")); !old.Has("v1") || !a.Load().Has("v2") {
+		t.Errorf("Unexpected Swap result %v, current %v", old, a.Load())
+	}
+	if a.CompareAndSwap(Val("v1"), Nul[string]()) {
+		t.Error("Expected CompareAndSwap to fail on a mismatch")
+	}
+	if !a.CompareAndSwap(Val("v2"), Nul[string]()) || a.Load().IsVal() {
+		t.Error("Expected CompareAndSwap to clear the value")
+	}
+	if !a.CompareAndSwap(Nul[string](), Val("v3")) || !a.Load().Has("v3") {
+		t.Error("Expected CompareAndSwap from None to succeed")
+	}
+}
+
+func TestAtomic_LoadOrStoreFunc(t *testing.T) {
+	var a Atomic[int]
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got := a.LoadOrStoreFunc(func() Option[int] {
+				calls.Add(1)
+				return Val(42)
+			})
+			if !got.Has(42) {
+				t.Errorf("Expected Some(42), got %v", got)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("Expected f to be called once, got %d", calls.Load())
+	}
+}
@@ -0,0 +1,122 @@
+package option
+
+import "testing"
+
+func BenchmarkVal(b *testing.B) {
+	b.ReportAllocs()
+	var o Option[int]
+	for i := range b.N {
+		o = Val(i)
+	}
+	_ = o
+}
+
+// 小值高频构造的场景：Option 按值保存，无需驻留（interning）即可避免堆分配，与装箱为指针对比
+func BenchmarkValSmall(b *testing.B) {
+	type status uint8
+	b.Run("Option", func(b *testing.B) {
+		b.ReportAllocs()
+		s := make([]Option[status], 1024)
+		for i := range b.N {
+			s[i%len(s)] = Val(status(i % 4))
+		}
+	})
+	b.Run("Pointer", func(b *testing.B) {
+		b.ReportAllocs()
+		s := make([]*status, 1024)
+		for i := range b.N {
+			v := status(i % 4)
+			s[i%len(s)] = &v
+		}
+	})
+}
+
+func BenchmarkGet(b *testing.B) {
+	b.ReportAllocs()
+	o := Val(1)
+	sum := 0
+	for range b.N {
+		sum += o.Get()
+	}
+	_ = sum
+}
+
+func BenchmarkGetOr(b *testing.B) {
+	b.ReportAllocs()
+	o := Nul[int]()
+	sum := 0
+	for range b.N {
+		sum += o.GetOr(1)
+	}
+	_ = sum
+}
+
+func BenchmarkMap(b *testing.B) {
+	b.ReportAllocs()
+	o := Val(1)
+	for range b.N {
+		o = Map(o, func(v int) int { return v + 1 })
+	}
+	_ = o
+}
+
+func BenchmarkThen(b *testing.B) {
+	b.ReportAllocs()
+	o := Val(1)
+	for range b.N {
+		o = Then(o, func(v int) Option[int] { return Val(v + 1) })
+	}
+	_ = o
+}
+
+func BenchmarkChain(b *testing.B) {
+	b.ReportAllocs()
+	sum := 0
+	for i := range b.N {
+		o := Map(Val(i), func(v int) int { return v * 2 }).Filter(func(v int) bool { return v%3 != 0 })
+		sum += Then(o, func(v int) Option[int] { return Val(v + 1) }).GetOr(0)
+	}
+	_ = sum
+}
+
+type benchPoint struct {
+	X, Y int
+	Tags []string
+}
+
+// 可比较类型直接使用 ==
+func BenchmarkHasComparable(b *testing.B) {
+	b.ReportAllocs()
+	o := Val(1)
+	n := 0
+	for range b.N {
+		if o.Has(1) {
+			n++
+		}
+	}
+	_ = n
+}
+
+// 包含切片的类型回退到 reflect.DeepEqual
+func BenchmarkHasDeepEqual(b *testing.B) {
+	b.ReportAllocs()
+	o := Val(benchPoint{1, 2, []string{"a"}})
+	want := benchPoint{1, 2, []string{"a"}}
+	n := 0
+	for range b.N {
+		if o.Has(want) {
+			n++
+		}
+	}
+	_ = n
+}
+
+func BenchmarkString(b *testing.B) {
+	b.ReportAllocs()
+	o := Val(1)
+	var s string
+	for range b.N {
+		s = o.String()
+	}
+	_ = s
+}
@@ -0,0 +1,174 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/clock"
+	"github.com/viocha/go-option/internal/common"
+	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/result"
+)
+
+type Option func(*config)
+
+type config struct {
+	ttl        time.Duration
+	maxEntries int
+	clock      clock.Clock
+}
+
+// 条目在写入 d 之后过期，默认永不过期
+func TTL(d time.Duration) Option {
+	return func(c *config) { c.ttl = d }
+}
+
+// 最多保存 n 个条目，超出时淘汰最久未使用的条目，默认不限制
+func MaxEntries(n int) Option {
+	return func(c *config) { c.maxEntries = n }
+}
+
+// 计算过期时间使用的时间来源，默认为 clock.Real
+func WithClock(clk clock.Clock) Option {
+	return func(c *config) { c.clock = clk }
+}
+
+// 可安全地并发使用的键值缓存，支持 TTL 和按最近使用淘汰，需通过 New 创建
+type Cache[K comparable, V any] struct {
+	mu       sync.Mutex
+	cfg      config
+	clock    clock.Clock
+	items    map[K]*list.Element
+	lru      *list.List
+	inflight map[K]*load[V]
+}
+
+type entry[K comparable, V any] struct {
+	key      K
+	val      V
+	expireAt time.Time
+}
+
+type load[V any] struct {
+	done chan struct{}
+	res  result.Result[V]
+}
+
+func New[K comparable, V any](opts ...Option) *Cache[K, V] {
+	c := &Cache[K, V]{items: make(map[K]*list.Element), lru: list.New(), inflight: make(map[K]*load[V])}
+	for _, o := range opts {
+		o(&c.cfg)
+	}
+	c.clock = clock.OrReal(c.cfg.clock)
+	return c
+}
+
+// 返回 k 对应的未过期的值，不存在时返回 Nul
+func (c *Cache[K, V]) Get(k K) opt.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.get(k)
+}
+
+func (c *Cache[K, V]) get(k K) opt.Option[V] {
+	elem, ok := c.items[k]
+	if !ok {
+		return opt.Nul[V]()
+	}
+	e := elem.Value.(*entry[K, V])
+	if !e.expireAt.IsZero() && !c.clock.Now().Before(e.expireAt) {
+		c.remove(elem)
+		return opt.Nul[V]()
+	}
+	c.lru.MoveToFront(elem)
+	return opt.Val(e.val)
+}
+
+// 保存 k 对应的值，覆盖已有的值
+func (c *Cache[K, V]) Put(k K, v V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.put(k, v)
+}
+
+func (c *Cache[K, V]) put(k K, v V) {
+	var expireAt time.Time
+	if c.cfg.ttl > 0 {
+		expireAt = c.clock.Now().Add(c.cfg.ttl)
+	}
+	if elem, ok := c.items[k]; ok {
+		c.remove(elem)
+	}
+	c.items[k] = c.lru.PushFront(&entry[K, V]{key: k, val: v, expireAt: expireAt})
+	if c.cfg.maxEntries > 0 && c.lru.Len() > c.cfg.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// 删除 k 对应的值，返回被删除的值
+func (c *Cache[K, V]) Delete(k K) opt.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old := c.get(k)
+	if elem, ok := c.items[k]; ok {
+		c.remove(elem)
+	}
+	return old
+}
+
+// 当前保存的条目数量，可能包含尚未被清理的过期条目
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *Cache[K, V]) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.items, elem.Value.(*entry[K, V]).key)
+}
+
+// 返回 k 对应的值，不存在时调用 loader 加载，Ok 时保存结果，Err 不会被缓存。
+// 相同 k 的并发加载只会调用一次 loader，loader 中的 ErrMust panic 会被转换为 Err。
+// 其他 panic 会继续传播，同时等待中的调用得到包含该 panic 的 Err
+func (c *Cache[K, V]) GetOrLoad(k K, loader func(K) result.Result[V]) result.Result[V] {
+	c.mu.Lock()
+	if v := c.get(k); v.IsVal() {
+		c.mu.Unlock()
+		return result.Ok(v.Get())
+	}
+	if l, ok := c.inflight[k]; ok {
+		c.mu.Unlock()
+		<-l.done
+		return l.res
+	}
+	l := &load[V]{done: make(chan struct{})}
+	c.inflight[k] = l
+	c.mu.Unlock()
+
+	var r result.Result[V]
+	common.OnAbort(func() {
+		if err := must.CatchMustPanic(func() { r = loader(k) }); err != nil {
+			r = result.Err[V](err)
+		}
+	}, func(err error) {
+		// loader 中的其他 panic 继续传播，等待中的调用得到 Err，之后的调用重新加载
+		c.mu.Lock()
+		delete(c.inflight, k)
+		l.res = result.Err[V](err)
+		close(l.done)
+		c.mu.Unlock()
+	})
+
+	c.mu.Lock()
+	if r.IsOk() {
+		c.put(k, r.Get())
+	}
+	delete(c.inflight, k)
+	l.res = r
+	close(l.done)
+	c.mu.Unlock()
+	return r
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/viocha/go-option/clock/clocktest"
+	"github.com/viocha/go-option/result"
+)
+
+func TestCache(t *testing.T) {
+	clk := clocktest.NewFake(time.Unix(0, 0))
+	c := New[string, int](TTL(time.Minute), MaxEntries(2), WithClock(clk))
+
+	if o := c.Get("a"); o.IsVal() {
+		t.Errorf("Expected None for a missing key, got %v", o)
+	}
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a") // a 成为最近使用的
+	c.Put("c", 3)
+	if c.Get("b").IsVal() || !c.Get("a").Has(1) || !c.Get("c").Has(3) {
+		t.Errorf("Expected b to be evicted, got len %d", c.Len())
+	}
+
+	clk.Advance(time.Minute)
+	if o := c.Get("a"); o.IsVal() {
+		t.Errorf("Expected a to expire, got %v", o)
+	}
+	c.Put("d", 4)
+	if o := c.Delete("d"); !o.Has(4) || c.Get("d").IsVal() {
+		t.Errorf("Expected d to be deleted, got %v", o)
+	}
+}
+
+func TestGetOrLoad(t *testing.T) {
+	c := New[int, string]()
+	var calls atomic.Int32
+	errDown := errors.New("down")
+	loader := func(k int) result.Result[string] {
+		calls.Add(1)
+		if k < 0 {
+			return result.Err[string](errDown)
+		}
+		return result.Ok("v")
+	}
+
+	if r := c.GetOrLoad(1, loader); !r.Has("v") {
+		t.Errorf("Expected Ok(v), got %v", r)
+	}
+	c.GetOrLoad(1, loader)
+	if calls.Load() != 1 {
+		t.Errorf("Expected the value to be cached, got %d calls", calls.Load())
+	}
+	c.GetOrLoad(-1, loader)
+	if r := c.GetOrLoad(-1, loader); !r.HasErr(errDown) || calls.Load() != 3 {
+		t.Errorf("Expected Err not to be cached, got %v after %d calls", r, calls.Load())
+	}
+}
+
+func TestGetOrLoad_Concurrent(t *testing.T) {
+	c := New[int, int]()
+	var calls atomic.Int32
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := c.GetOrLoad(1, func(k int) result.Result[int] {
+				calls.Add(1)
+				<-release
+				return result.Ok(k)
+			})
+			if !r.Has(1) {
+				t.Errorf("Expected Ok(1), got %v", r)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single load, got %d", calls.Load())
+	}
+}
+
+func TestGetOrLoad_Panic(t *testing.T) {
+	c := New[int, int]()
+	errBoom := errors.New("boom")
+	started, release := make(chan struct{}), make(chan struct{})
+	panicked := make(chan any)
+	go func() {
+		defer func() { panicked <- recover() }()
+		c.GetOrLoad(1, func(int) result.Result[int] {
+			close(started)
+			<-release
+			panic(errBoom)
+		})
+	}()
+	<-started
+	waiter := make(chan result.Result[int])
+	go func() {
+		waiter <- c.GetOrLoad(1, func(k int) result.Result[int] { return result.Ok(k) })
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+
+	if v := <-panicked; v != errBoom {
+		t.Errorf("Expected the panic to propagate, got %v", v)
+	}
+	select {
+	case r := <-waiter:
+		if !r.HasErr(errBoom) && !r.Has(1) {
+			t.Errorf("Expected the waiter to get Err(boom) or a fresh Ok(1), got %v", r)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the waiter not to block forever")
+	}
+	if r := c.GetOrLoad(1, func(k int) result.Result[int] { return result.Ok(k) }); !r.Has(1) {
+		t.Errorf("Expected the key to be loaded again after the panic, got %v", r)
+	}
+}
@@ -0,0 +1,12 @@
+package option
+
+// ========================== channel =============================
+
+// 从 ch 中接收一个值，ch 已关闭时返回 Nul
+func Recv[T any](ch <-chan T) Option[T] {
+	v, ok := <-ch
+	if !ok {
+		return Nul[T]()
+	}
+	return Val(v)
+}
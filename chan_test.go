@@ -0,0 +1,15 @@
+package option
+
+import "testing"
+
+func TestRecv(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 5
+	close(ch)
+	if got := Recv(ch); !got.Has(5) {
+		t.Errorf("Expected Some(5), got %v", got)
+	}
+	if got := Recv(ch); got.IsVal() {
+		t.Errorf("Expected None from a closed channel, got %v", got)
+	}
+}
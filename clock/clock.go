@@ -0,0 +1,27 @@
+package clock
+
+import "time"
+
+// 时间来源，需要等待或计算时间的功能（重试、Watchdog、缓存 TTL 等）通过它获取时间，
+// 以便在测试中使用 clocktest.Fake 代替真实的时间
+type Clock interface {
+	Now() time.Time
+	// d 之后发送当前时间的 channel
+	After(d time.Duration) <-chan time.Time
+}
+
+// 使用系统时间的 Clock
+var Real Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// c 为 nil 时返回 Real
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return Real
+	}
+	return c
+}
@@ -0,0 +1,26 @@
+package clocktest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := NewFake(start)
+	ch := f.After(time.Minute)
+
+	f.Advance(30 * time.Second)
+	select {
+	case <-ch:
+		t.Fatal("Expected After not to fire before the deadline")
+	default:
+	}
+	f.Advance(30 * time.Second)
+	if got := <-ch; !got.Equal(start.Add(time.Minute)) {
+		t.Errorf("Expected After to fire at %v, got %v", start.Add(time.Minute), got)
+	}
+	if f.Waiters() != 0 || !f.Now().Equal(start.Add(time.Minute)) {
+		t.Errorf("Unexpected state: %d waiters, now %v", f.Waiters(), f.Now())
+	}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// 一个 Option 访问器
+type accessor struct {
+	Method string // 方法名
+	Field  string // 字段名
+	JSON   string // JSON 字段名，没有 json 标签时为空
+	Type   string // Option 中值的类型
+	Expr   string // 构造 Option 的表达式，x 为接收者
+
+	pkgs []string // Type 中引用的包
+}
+
+// database/sql 中的 Null 类型及其值字段
+var sqlNullValues = map[string]struct{ typ, field, pkg string }{
+	"NullString":  {"string", "String", ""},
+	"NullInt64":   {"int64", "Int64", ""},
+	"NullInt32":   {"int32", "Int32", ""},
+	"NullInt16":   {"int16", "Int16", ""},
+	"NullByte":    {"byte", "Byte", ""},
+	"NullFloat64": {"float64", "Float64", ""},
+	"NullBool":    {"bool", "Bool", ""},
+	"NullTime":    {"time.Time", "Time", "time"},
+}
+
+// 表示「值是否存在」的伴随 bool 字段名
+func presenceFields(name string) []string {
+	return []string{name + "Valid", name + "Set", name + "Ok", "Has" + name}
+}
+
+var accessorTmpl = template.Must(template.New("").Parse(`// Code generated by optiongen; DO NOT EDIT.
+
+package {{.Pkg}}
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+{{if .Imports}}
+{{end}}	option "github.com/viocha/go-option"
+)
+{{range .Accessors}}
+// 以 Option 的形式返回 {{.Field}}{{if .JSON}}（JSON: {{.JSON}}）{{end}}
+func (x *{{$.Struct}}) {{.Method}}() option.Option[{{.Type}}] {
+	return {{.Expr}}
+}
+{{end}}`))
+
+// 解析 dir 中包 pkg 的 Go 源文件，为结构体 name 生成 Option 访问器：
+// 指针字段、database/sql 的 Null 类型字段，以及带有 XValid/XSet/XOk/HasX 伴随 bool 字段的 X 字段。
+// json:"-" 或 optiongen:"-" 的字段会被跳过
+func generateAccessors(dir, pkg, name string) ([]byte, error) {
+	file, st, err := findStruct(dir, pkg, name)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]ast.Expr)
+	for _, f := range st.Fields.List {
+		for _, n := range f.Names {
+			fields[n.Name] = f.Type
+		}
+	}
+
+	var accessors []accessor
+	for _, f := range st.Fields.List {
+		tag := reflect.StructTag("")
+		if f.Tag != nil {
+			tag = reflect.StructTag(strings.Trim(f.Tag.Value, "`"))
+		}
+		jsonName, _, _ := strings.Cut(tag.Get("json"), ",")
+		if jsonName == "-" || tag.Get("optiongen") == "-" {
+			continue
+		}
+		for _, n := range f.Names {
+			if !n.IsExported() {
+				continue
+			}
+			if a, ok := makeAccessor(n.Name, f.Type, fields); ok {
+				a.JSON = jsonName
+				accessors = append(accessors, a)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	err = accessorTmpl.Execute(&buf, map[string]any{
+		"Pkg": pkg, "Struct": name, "Imports": usedImports(file, accessors), "Accessors": accessors,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func makeAccessor(name string, typ ast.Expr, fields map[string]ast.Expr) (accessor, bool) {
+	a := accessor{Method: "Get" + name, Field: name}
+	if star, ok := typ.(*ast.StarExpr); ok {
+		a.Type, a.pkgs = types.ExprString(star.X), referencedPkgs(star.X)
+		a.Expr = fmt.Sprintf("option.FromPtr(x.%s)", name)
+		return a, true
+	}
+	if sel, ok := typ.(*ast.SelectorExpr); ok && types.ExprString(sel.X) == "sql" {
+		if v, ok := sqlNullValues[sel.Sel.Name]; ok {
+			a.Type = v.typ
+			if v.pkg != "" {
+				a.pkgs = []string{v.pkg}
+			}
+			a.Expr = fmt.Sprintf("option.FromOk(x.%s.%s, x.%s.Valid)", name, v.field, name)
+			return a, true
+		}
+	}
+	for _, p := range presenceFields(name) {
+		if t, ok := fields[p]; ok && types.ExprString(t) == "bool" {
+			a.Type, a.pkgs = types.ExprString(typ), referencedPkgs(typ)
+			a.Expr = fmt.Sprintf("option.FromOk(x.%s, x.%s)", name, p)
+			return a, true
+		}
+	}
+	return a, false
+}
+
+// 类型表达式中引用的包名
+func referencedPkgs(expr ast.Expr) []string {
+	var pkgs []string
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				pkgs = append(pkgs, id.Name)
+			}
+		}
+		return true
+	})
+	return pkgs
+}
+
+// 访问器中用到的 import，优先使用结构体所在文件中的 import 声明
+func usedImports(file *ast.File, accessors []accessor) []string {
+	declared := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		local, line := path[strings.LastIndex(path, "/")+1:], spec.Path.Value
+		if spec.Name != nil {
+			local, line = spec.Name.Name, spec.Name.Name+" "+spec.Path.Value
+		}
+		declared[local] = line
+	}
+	var lines []string
+	for _, a := range accessors {
+		for _, pkg := range a.pkgs {
+			line, ok := declared[pkg]
+			if !ok {
+				line = strconv.Quote(pkg)
+			}
+			if !slices.Contains(lines, line) {
+				lines = append(lines, line)
+			}
+		}
+	}
+	slices.Sort(lines)
+	return lines
+}
+
+// 在 dir 中包 pkg 的非测试源文件中查找结构体 name
+func findStruct(dir, pkg, name string) (*ast.File, *ast.StructType, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, err
+	}
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		file, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, nil, err
+		}
+		if file.Name.Name != pkg {
+			continue
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if st, ok := ts.Type.(*ast.StructType); ok && ts.Name.Name == name {
+					return file, st, nil
+				}
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("struct %s not found in package %s", name, pkg)
+}
+
+// 生成文件名中使用的小写名称
+func snakeName(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,111 @@
+// optiongen 为指定的类型生成固定目标类型的 Then/Map 适配函数，用于代替 ThenInt/MapStr 这类硬编码的方法；
+// 或者为结构体中的可选字段生成返回 Option 的访问器。
+//
+// 用法（在目标包中）：
+//
+//	//go:generate go run github.com/viocha/go-option/cmd/optiongen -types User,int -result
+//	//go:generate go run github.com/viocha/go-option/cmd/optiongen -struct User
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+func main() {
+	types := flag.String("types", "", "逗号分隔的目标类型名，只支持当前包中的类型和内置类型")
+	structName := flag.String("struct", "", "为该结构体生成 Option 访问器")
+	pkg := flag.String("pkg", os.Getenv("GOPACKAGE"), "生成文件的包名，默认为 go generate 提供的 $GOPACKAGE")
+	output := flag.String("o", "", "输出文件，默认为 option_gen.go 或 <struct>_option_gen.go")
+	withResult := flag.Bool("result", false, "同时生成 result 包的适配函数")
+	flag.Parse()
+
+	if (*types == "") == (*structName == "") || *pkg == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var src []byte
+	var err error
+	if *structName != "" {
+		src, err = generateAccessors(".", *pkg, *structName)
+		if *output == "" {
+			*output = snakeName(*structName) + "_option_gen.go"
+		}
+	} else {
+		src, err = generate(*pkg, strings.Split(*types, ","), *withResult)
+		if *output == "" {
+			*output = "option_gen.go"
+		}
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type adapter struct {
+	Type string // 目标类型
+	Name string // 函数名后缀
+}
+
+var tmpl = template.Must(template.New("").Parse(`// Code generated by optiongen; DO NOT EDIT.
+
+package {{.Pkg}}
+
+import (
+	option "github.com/viocha/go-option"
+{{- if .Result}}
+	"github.com/viocha/go-option/result"
+{{- end}}
+)
+{{range .Adapters}}
+// 目标类型为 {{.Type}} 的 option.Then
+func Then{{.Name}}[T any](o option.Option[T], f func(T) option.Option[{{.Type}}]) option.Option[{{.Type}}] {
+	return option.Then(o, f)
+}
+
+// 目标类型为 {{.Type}} 的 option.Map
+func Map{{.Name}}[T any](o option.Option[T], f func(T) {{.Type}}) option.Option[{{.Type}}] {
+	return option.Map(o, f)
+}
+{{if $.Result}}
+// 目标类型为 {{.Type}} 的 result.Then
+func Then{{.Name}}Result[T any](r result.Result[T], f func(T) result.Result[{{.Type}}]) result.Result[{{.Type}}] {
+	return result.Then(r, f)
+}
+
+// 目标类型为 {{.Type}} 的 result.Map
+func Map{{.Name}}Result[T any](r result.Result[T], f func(T) {{.Type}}) result.Result[{{.Type}}] {
+	return result.Map(r, f)
+}
+{{end}}{{end}}`))
+
+// 生成适配函数的源码
+func generate(pkg string, types []string, withResult bool) ([]byte, error) {
+	var adapters []adapter
+	for _, typ := range types {
+		typ = strings.TrimSpace(typ)
+		if !token.IsIdentifier(typ) {
+			return nil, fmt.Errorf("unsupported type %q: only identifiers are allowed", typ)
+		}
+		name := []rune(typ)
+		name[0] = unicode.ToUpper(name[0])
+		adapters = append(adapters, adapter{Type: typ, Name: string(name)})
+	}
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, map[string]any{"Pkg": pkg, "Result": withResult, "Adapters": adapters})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	src, err := generate("models", []string{"User", "int"}, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "option_gen.go", src, 0)
+	if err != nil {
+		t.Fatalf("Generated code does not parse: %v\n%s", err, src)
+	}
+	var funcs []string
+	for _, d := range f.Decls {
+		if name := declName(d); name != "" {
+			funcs = append(funcs, name)
+		}
+	}
+	want := "ThenUser MapUser ThenUserResult MapUserResult ThenInt MapInt ThenIntResult MapIntResult"
+	if got := strings.Join(funcs, " "); got != want {
+		t.Errorf("Expected functions %q, got %q", want, got)
+	}
+
+	if _, err := generate("models", []string{"time.Duration"}, false); err == nil {
+		t.Error("Expected an error for a qualified type")
+	}
+}
+
+func declName(d ast.Decl) string {
+	if fn, ok := d.(*ast.FuncDecl); ok {
+		return fn.Name.Name
+	}
+	return ""
+}
+
+const modelSrc = `package models
+
+import (
+	"os"
+	"path/filepath"
+	"database/sql"
+	"time"
+)
+
+type User struct {
+	Name      *string    ` + "`json:\"name\"`" + `
+	Birthday  *time.Time ` + "`json:\"birthday,omitempty\"`" + `
+	Email     sql.NullString
+	Age       int
+	AgeValid  bool
+	Password  *string ` + "`json:\"-\"`" + `
+	Nickname  string
+	nickname2 *string
+}
+`
+
+func TestGenerateAccessors(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "models.go"), []byte(modelSrc), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	src, err := generateAccessors(dir, "models", "User")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "user_option_gen.go", src, 0)
+	if err != nil {
+		t.Fatalf("Generated code does not parse: %v\n%s", err, src)
+	}
+	var funcs []string
+	for _, d := range f.Decls {
+		if name := declName(d); name != "" {
+			funcs = append(funcs, name)
+		}
+	}
+	if got, want := strings.Join(funcs, " "), "GetName GetBirthday GetEmail GetAge"; got != want {
+		t.Errorf("Expected accessors %q, got %q", want, got)
+	}
+	for _, want := range []string{
+		`"time"`,
+		"option.Option[time.Time]",
+		"option.FromOk(x.Email.String, x.Email.Valid)",
+		"option.FromOk(x.Age, x.AgeValid)",
+		"JSON: name",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("Expected %s in generated code:\n%s", want, src)
+		}
+	}
+	if strings.Contains(string(src), `"database/sql"`) {
+		t.Errorf("Expected database/sql not to be imported:\n%s", src)
+	}
+
+	if _, err := generateAccessors(dir, "models", "Missing"); err == nil {
+		t.Error("Expected an error for a missing struct")
+	}
+}
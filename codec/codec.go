@@ -0,0 +1,136 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+var ErrInvalidResult = errors.New("invalid Result encoding")
+
+// ========================== 编码格式 =============================
+
+// 一种序列化格式。Marshal/Unmarshal 通常直接使用编解码库的顶层函数，如 cbor.Marshal、msgpack.Unmarshal
+type Format struct {
+	Marshal   func(v any) ([]byte, error)
+	Unmarshal func(data []byte, v any) error
+	Null      []byte // 该格式中 null 的编码
+	// 将编码后的数组拆分为各元素的编码，使元素可以直接解码为目标类型。
+	// 为 nil 时元素先解码为 any 再重新编码，数值可能经过通用类型（如 JSON 的 float64）而丢失精度
+	SplitArray func(data []byte) ([][]byte, error)
+}
+
+// 使用 encoding/json 的格式
+var JSON = Format{Marshal: json.Marshal, Unmarshal: json.Unmarshal, Null: []byte("null"), SplitArray: splitJSONArray}
+
+func splitJSONArray(data []byte) ([][]byte, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	elems := make([][]byte, len(raw))
+	for i, r := range raw {
+		elems[i] = r
+	}
+	return elems, nil
+}
+
+// CBOR 格式，null 编码为 0xf6，例如 codec.CBOR(cbor.Marshal, cbor.Unmarshal)
+func CBOR(marshal func(any) ([]byte, error), unmarshal func([]byte, any) error) Format {
+	return Format{Marshal: marshal, Unmarshal: unmarshal, Null: []byte{0xf6}}
+}
+
+// MessagePack 格式，nil 编码为 0xc0，例如 codec.MsgPack(msgpack.Marshal, msgpack.Unmarshal)
+func MsgPack(marshal func(any) ([]byte, error), unmarshal func([]byte, any) error) Format {
+	return Format{Marshal: marshal, Unmarshal: unmarshal, Null: []byte{0xc0}}
+}
+
+// ========================== Option =============================
+
+// Nul 编码为 null，Val(v) 编码为 v 本身。
+// 结构体字段需要在 Nul 时完全省略时，配合编解码库的 omitempty 使用（Option 实现了 IsZero）
+func MarshalOption[T any](f Format, o opt.Option[T]) ([]byte, error) {
+	if o.IsNul() {
+		return f.Null, nil
+	}
+	return f.Marshal(o.Get())
+}
+
+// null 解码为 Nul，其余解码为 Val
+func UnmarshalOption[T any](f Format, data []byte, o *opt.Option[T]) error {
+	if bytes.Equal(data, f.Null) {
+		*o = opt.Nul[T]()
+		return nil
+	}
+	var v T
+	if err := f.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = opt.Val(v)
+	return nil
+}
+
+// ========================== Result =============================
+
+// Ok(v) 编码为 [true, v]，Err 编码为 [false, 错误消息]
+func MarshalResult[T any](f Format, r result.Result[T]) ([]byte, error) {
+	if r.IsErr() {
+		return f.Marshal([]any{false, r.GetErr().Error()})
+	}
+	return f.Marshal([]any{true, r.Get()})
+}
+
+// 解码 MarshalResult 的输出。Err 解码为以原消息构造的错误
+func UnmarshalResult[T any](f Format, data []byte, r *result.Result[T]) error {
+	ok, second, err := splitPair(f, data)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		var msg string
+		if err := f.Unmarshal(second, &msg); err != nil {
+			return ErrInvalidResult
+		}
+		*r = result.Err[T](errors.New(msg))
+		return nil
+	}
+	var v T
+	if err := f.Unmarshal(second, &v); err != nil {
+		return err
+	}
+	*r = result.Ok(v)
+	return nil
+}
+
+// 拆分 [bool, x] 形式的编码，返回其中的 bool 以及 x 的编码
+func splitPair(f Format, data []byte) (bool, []byte, error) {
+	var ok bool
+	if f.SplitArray != nil {
+		elems, err := f.SplitArray(data)
+		if err != nil {
+			return false, nil, err
+		}
+		if len(elems) != 2 || f.Unmarshal(elems[0], &ok) != nil {
+			return false, nil, ErrInvalidResult
+		}
+		return ok, elems[1], nil
+	}
+
+	var pair []any
+	if err := f.Unmarshal(data, &pair); err != nil {
+		return false, nil, err
+	}
+	if len(pair) != 2 {
+		return false, nil, ErrInvalidResult
+	}
+	ok, isBool := pair[0].(bool)
+	if !isBool {
+		return false, nil, ErrInvalidResult
+	}
+	// 通用解码得到的值类型不确定，重新编码后再解码
+	second, err := f.Marshal(pair[1])
+	return ok, second, err
+}
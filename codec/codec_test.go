@@ -0,0 +1,65 @@
+package codec
+
+import (
+	"errors"
+	"testing"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+func TestOption(t *testing.T) {
+	for _, o := range []opt.Option[int]{opt.Val(0), opt.Nul[int]()} {
+		data, err := MarshalOption(JSON, o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got opt.Option[int]
+		if err := UnmarshalOption(JSON, data, &got); err != nil || got != o {
+			t.Errorf("Round trip of %v gave %v (%v)", o, got, err)
+		}
+	}
+}
+
+type user struct {
+	Name string
+}
+
+func TestResult(t *testing.T) {
+	data, err := MarshalResult(JSON, result.Ok(user{"a"}))
+	if err != nil || string(data) != `[true,{"Name":"a"}]` {
+		t.Fatalf("Unexpected encoding %s (%v)", data, err)
+	}
+	var r result.Result[user]
+	if err := UnmarshalResult(JSON, data, &r); err != nil || !r.Has(user{"a"}) {
+		t.Errorf("Expected Ok(user), got %v (%v)", r, err)
+	}
+
+	data, _ = MarshalResult(JSON, result.Err[user](errors.New("fail")))
+	if err := UnmarshalResult(JSON, data, &r); err != nil || r.IsOk() || r.GetErr().Error() != "fail" {
+		t.Errorf("Expected Err(fail), got %v (%v)", r, err)
+	}
+	if err := UnmarshalResult(JSON, []byte(`[true]`), &r); err != ErrInvalidResult {
+		t.Errorf("Expected ErrInvalidResult, got %v", err)
+	}
+}
+
+func TestResult_LargeInt(t *testing.T) {
+	const big = int64(1)<<62 + 1
+	data, _ := MarshalResult(JSON, result.Ok(big))
+	var r result.Result[int64]
+	if err := UnmarshalResult(JSON, data, &r); err != nil || !r.Has(big) {
+		t.Errorf("Expected Ok(%d), got %v (%v)", big, r, err)
+	}
+
+	// 没有 SplitArray 的格式仍然通过通用解码工作
+	plain := Format{Marshal: JSON.Marshal, Unmarshal: JSON.Unmarshal, Null: JSON.Null}
+	var u result.Result[user]
+	data, _ = MarshalResult(plain, result.Ok(user{"a"}))
+	if err := UnmarshalResult(plain, data, &u); err != nil || !u.Has(user{"a"}) {
+		t.Errorf("Expected Ok(user), got %v (%v)", u, err)
+	}
+	if err := UnmarshalResult(JSON, []byte(`["x", 1]`), &u); err != ErrInvalidResult {
+		t.Errorf("Expected ErrInvalidResult, got %v", err)
+	}
+}
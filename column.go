@@ -0,0 +1,110 @@
+package option
+
+import "math/bits"
+
+// ========================== 列式存储 =============================
+
+// Option 的列式表示：值保存在连续的切片中，是否存在值保存在位图中，
+// 与 []Option[T] 相比每个元素只额外占用 1 bit
+type Column[T any] struct {
+	values []T
+	valid  []uint64
+}
+
+// 创建长度为 n、所有元素都为 Nul 的列
+func NewColumn[T any](n int) *Column[T] {
+	return &Column[T]{values: make([]T, n), valid: make([]uint64, (n+63)/64)}
+}
+
+// 从 []Option[T] 构造列
+func ColumnOf[T any](opts []Option[T]) *Column[T] {
+	c := NewColumn[T](len(opts))
+	for i, o := range opts {
+		c.Set(i, o)
+	}
+	return c
+}
+
+func (c *Column[T]) Len() int {
+	return len(c.values)
+}
+
+// 第 i 个元素是否存在值
+func (c *Column[T]) IsVal(i int) bool {
+	_ = c.values[i]
+	return c.valid[i/64]&(1<<(i%64)) != 0
+}
+
+// 不存在值的元素数量
+func (c *Column[T]) NulCount() int {
+	n := 0
+	for _, w := range c.valid {
+		n += bits.OnesCount64(w)
+	}
+	return len(c.values) - n
+}
+
+// 第 i 个元素
+func (c *Column[T]) Get(i int) Option[T] {
+	if !c.IsVal(i) {
+		return Nul[T]()
+	}
+	return Val(c.values[i])
+}
+
+// 设置第 i 个元素
+func (c *Column[T]) Set(i int, o Option[T]) {
+	if o.IsVal() {
+		c.values[i] = o.Get()
+		c.valid[i/64] |= 1 << (i % 64)
+		return
+	}
+	c.values[i] = *new(T)
+	c.valid[i/64] &^= 1 << (i % 64)
+}
+
+// 在末尾追加一个元素
+func (c *Column[T]) Append(o Option[T]) {
+	c.values = append(c.values, *new(T))
+	if len(c.values) > len(c.valid)*64 {
+		c.valid = append(c.valid, 0)
+	}
+	c.Set(len(c.values)-1, o)
+}
+
+// 底层的值切片，不存在值的位置为零值。返回的切片与列共享内存
+func (c *Column[T]) Values() []T {
+	return c.values
+}
+
+// 转换为 []Option[T]
+func (c *Column[T]) Options() []Option[T] {
+	opts := make([]Option[T], c.Len())
+	for i := range opts {
+		opts[i] = c.Get(i)
+	}
+	return opts
+}
+
+// 返回新列，不满足 f 的元素变为 Nul，元素位置保持不变
+func (c *Column[T]) Filter(f func(T) bool) *Column[T] {
+	out := &Column[T]{values: make([]T, c.Len()), valid: make([]uint64, len(c.valid))}
+	for i, v := range c.values {
+		if c.IsVal(i) && f(v) {
+			out.values[i] = v
+			out.valid[i/64] |= 1 << (i % 64)
+		}
+	}
+	return out
+}
+
+// 对存在的值逐个应用 f，返回新列，位图保持不变
+func MapColumn[T, U any](c *Column[T], f func(T) U) *Column[U] {
+	out := &Column[U]{values: make([]U, c.Len()), valid: append([]uint64(nil), c.valid...)}
+	for i, v := range c.values {
+		if c.IsVal(i) {
+			out.values[i] = f(v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+package option
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestColumn(t *testing.T) {
+	opts := make([]Option[int], 130)
+	for i := range opts {
+		if i%3 != 0 {
+			opts[i] = Val(i)
+		}
+	}
+	c := ColumnOf(opts)
+	if c.Len() != 130 || c.NulCount() != 44 {
+		t.Fatalf("Expected 130 elements with 44 Nul, got %d with %d", c.Len(), c.NulCount())
+	}
+	if !slices.Equal(c.Options(), opts) {
+		t.Error("Expected the round trip through Column to preserve all Options")
+	}
+
+	c.Append(Val(1000))
+	c.Append(Nul[int]())
+	if !c.Get(130).Has(1000) || c.Get(131).IsVal() || c.Len() != 132 {
+		t.Errorf("Unexpected appended elements: %v, %v", c.Get(130), c.Get(131))
+	}
+	c.Set(1, Nul[int]())
+	if c.IsVal(1) || c.Values()[1] != 0 {
+		t.Error("Expected Set(Nul) to clear the element")
+	}
+}
+
+func TestColumn_MapFilter(t *testing.T) {
+	c := ColumnOf([]Option[int]{Val(1), Nul[int](), Val(2), Val(3)})
+	even := c.Filter(func(v int) bool { return v%2 == 0 })
+	if want := []Option[int]{Nul[int](), Nul[int](), Val(2), Nul[int]()}; !slices.Equal(even.Options(), want) {
+		t.Errorf("Expected %v, got %v", want, even.Options())
+	}
+	labels := MapColumn(c, func(v int) string { return string(rune('a' + v)) })
+	if want := []Option[string]{Val("b"), Nul[string](), Val("c"), Val("d")}; !slices.Equal(labels.Options(), want) {
+		t.Errorf("Expected %v, got %v", want, labels.Options())
+	}
+}
@@ -0,0 +1,106 @@
+package option
+
+import (
+	"cmp"
+	"slices"
+)
+
+// ========================== 排序比较 =============================
+
+// 比较两个 Option：Nul 小于任何 Val，两个 Val 使用 cmp.Compare 比较。
+// 可以直接作为 slices.SortFunc 的比较函数
+func Compare[T cmp.Ordered](a, b Option[T]) int {
+	return CompareFunc(a, b, cmp.Compare[T])
+}
+
+// 比较两个 Option：Nul 小于任何 Val，两个 Val 使用 compare 比较
+func CompareFunc[T any](a, b Option[T], compare func(T, T) int) int {
+	switch {
+	case a.IsNul() && b.IsNul():
+		return 0
+	case a.IsNul():
+		return -1
+	case b.IsNul():
+		return 1
+	}
+	return compare(a.Get(), b.Get())
+}
+
+// 使用 compare 构造一个 Option 的比较函数，可以直接作为 slices.SortFunc 的比较函数
+func Comparator[T any](compare func(T, T) int) func(a, b Option[T]) int {
+	return func(a, b Option[T]) int {
+		return CompareFunc(a, b, compare)
+	}
+}
+
+// Nul 在排序结果中的位置
+type NulOrder int
+
+const (
+	NulFirst NulOrder = iota // Nul 排在所有 Val 之前
+	NulLast                  // Nul 排在所有 Val 之后
+)
+
+// 对 s 进行原地稳定排序，Val 按升序排列，Nul 的位置由 order 决定
+func SortSlice[T cmp.Ordered](s []Option[T], order NulOrder) {
+	slices.SortStableFunc(s, func(a, b Option[T]) int {
+		c := Compare(a, b)
+		if order == NulLast && (a.IsNul() || b.IsNul()) {
+			return -c
+		}
+		return c
+	})
+}
+
+// 返回去除重复 Val 后的新切片。与 slices.Compact 不同，去除的是整个切片中的重复值而不只是相邻的重复值，
+// 只保留每个值第一次出现的位置，Nul 全部保留，顺序不变
+func CompactSome[T comparable](s []Option[T]) []Option[T] {
+	seen := make(map[T]bool)
+	out := make([]Option[T], 0, len(s))
+	for _, o := range s {
+		if o.IsVal() {
+			if seen[o.Get()] {
+				continue
+			}
+			seen[o.Get()] = true
+		}
+		out = append(out, o)
+	}
+	return out
+}
+
+// 存在值时将其限制在 [lo, hi] 范围内，否则返回 Nul
+func Clamp[T cmp.Ordered](o Option[T], lo, hi T) Option[T] {
+	return Map(o, func(v T) T { return min(max(v, lo), hi) })
+}
+
+// 存在值时返回限制在 [lo, hi] 范围内的值，否则返回 fallback
+func ClampOr[T cmp.Ordered](o Option[T], lo, hi, fallback T) T {
+	return Clamp(o, lo, hi).GetOr(fallback)
+}
+
+// 存在值且位于 [lo, hi] 范围内时保留，否则返回 Nul
+func FilterRange[T cmp.Ordered](o Option[T], lo, hi T) Option[T] {
+	return o.Filter(func(v T) bool { return v >= lo && v <= hi })
+}
+
+// 返回所有存在的值中最小的一个，忽略 Nul，没有任何值时返回 Nul
+func Min[T cmp.Ordered](opts ...Option[T]) Option[T] {
+	return pick(opts, func(x, y T) bool { return cmp.Less(x, y) })
+}
+
+// 返回所有存在的值中最大的一个，忽略 Nul，没有任何值时返回 Nul
+func Max[T cmp.Ordered](opts ...Option[T]) Option[T] {
+	return pick(opts, func(x, y T) bool { return cmp.Less(y, x) })
+}
+
+// 返回存在的值中按 better 最优的一个，相同时保留靠前的值
+func pick[T any](opts []Option[T], better func(x, y T) bool) Option[T] {
+	best := Nul[T]()
+	for _, o := range opts {
+		if o.IsVal() && (best.IsNul() || better(o.Get(), best.Get())) {
+			best = o
+		}
+	}
+	return best
+}
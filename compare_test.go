@@ -0,0 +1,90 @@
+package option
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	opts := []Option[int]{Val(3), Nul[int](), Val(1), Nul[int](), Val(2)}
+	slices.SortFunc(opts, Compare[int])
+	want := []Option[int]{Nul[int](), Nul[int](), Val(1), Val(2), Val(3)}
+	if !slices.Equal(opts, want) {
+		t.Errorf("Expected %v, got %v", want, opts)
+	}
+	if Compare(Val(1), Val(1)) != 0 || Compare(Nul[int](), Nul[int]()) != 0 {
+		t.Error("Expected equal Options to compare as 0")
+	}
+}
+
+func TestComparator(t *testing.T) {
+	names := []Option[string]{Val("bob"), Nul[string](), Val("Alice")}
+	slices.SortFunc(names, Comparator(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}))
+	want := []Option[string]{Nul[string](), Val("Alice"), Val("bob")}
+	if !slices.Equal(names, want) {
+		t.Errorf("Expected %v, got %v", want, names)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	opts := []Option[float64]{Nul[float64](), Val(2.5), Val(-1.0), Val(7.0)}
+	if got := Min(opts...); !got.Has(-1.0) {
+		t.Errorf("Expected Min -1, got %v", got)
+	}
+	if got := Max(opts...); !got.Has(7.0) {
+		t.Errorf("Expected Max 7, got %v", got)
+	}
+	if got := Min(Nul[int](), Nul[int]()); got.IsVal() {
+		t.Errorf("Expected None, got %v", got)
+	}
+}
+
+func TestSortSlice(t *testing.T) {
+	opts := []Option[int]{Val(3), Nul[int](), Val(1), Nul[int](), Val(2)}
+	SortSlice(opts, NulLast)
+	want := []Option[int]{Val(1), Val(2), Val(3), Nul[int](), Nul[int]()}
+	if !slices.Equal(opts, want) {
+		t.Errorf("Expected %v, got %v", want, opts)
+	}
+	SortSlice(opts, NulFirst)
+	want = []Option[int]{Nul[int](), Nul[int](), Val(1), Val(2), Val(3)}
+	if !slices.Equal(opts, want) {
+		t.Errorf("Expected %v, got %v", want, opts)
+	}
+}
+
+func TestCompactSome(t *testing.T) {
+	opts := []Option[string]{Val("b"), Nul[string](), Val("a"), Val("b"), Nul[string](), Val("a")}
+	got := CompactSome(opts)
+	want := []Option[string]{Val("b"), Nul[string](), Val("a"), Nul[string]()}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	type point struct{ X, Y int }
+	pts := CompactSome([]Option[point]{Val(point{1, 2}), Val(point{1, 2}), Val(point{2, 1})})
+	if len(pts) != 2 {
+		t.Errorf("Expected comparable structs to be deduplicated, got %v", pts)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	if v := ClampOr(Val(150), 1, 100, 10); v != 100 {
+		t.Errorf("Expected 100, got %v", v)
+	}
+	if v := ClampOr(Val(0), 1, 100, 10); v != 1 {
+		t.Errorf("Expected 1, got %v", v)
+	}
+	if v := ClampOr(Nul[int](), 1, 100, 10); v != 10 {
+		t.Errorf("Expected the fallback, got %v", v)
+	}
+	if o := FilterRange(Val(1.5), 0, 1); o.IsVal() {
+		t.Errorf("Expected None for an out of range value, got %v", o)
+	}
+	if o := FilterRange(Val("b"), "a", "c"); !o.Has("b") {
+		t.Errorf("Expected Some(b), got %v", o)
+	}
+}
@@ -0,0 +1,113 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+
+	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/result"
+)
+
+// 消息处理成功时 Result 中的值
+type Ack struct{}
+
+// 对一条消息的处理决定
+type Action int
+
+const (
+	ActionAck        Action = iota // 确认消息
+	ActionNack                     // 拒绝消息，由消息系统重新投递
+	ActionDeadLetter               // 投递到死信队列，不再重试
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionAck:
+		return "ack"
+	case ActionNack:
+		return "nack"
+	case ActionDeadLetter:
+		return "dead-letter"
+	}
+	return "unknown"
+}
+
+// 一次消息投递，由具体的消息系统（Kafka、NATS 等）适配实现
+type Delivery interface {
+	Ack(ctx context.Context) error
+	Nack(ctx context.Context) error
+	DeadLetter(ctx context.Context, cause error) error
+}
+
+// 不应重试的错误
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// 将 err 标记为永久错误，默认的分类器会将其投递到死信队列
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// err 的错误链中是否存在被 Permanent 标记的错误
+func IsPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
+
+// 默认的错误分类：永久错误投递到死信队列，其余错误重新投递
+func DefaultClassify(err error) Action {
+	if IsPermanent(err) {
+		return ActionDeadLetter
+	}
+	return ActionNack
+}
+
+type Option func(*config)
+
+type config struct {
+	classify func(error) Action
+}
+
+// 使用 f 对 Err 进行分类，代替 DefaultClassify
+func WithClassifier(f func(error) Action) Option {
+	return func(c *config) { c.classify = f }
+}
+
+// 根据处理结果决定如何处理消息
+func Decide(r result.Result[Ack], classify func(error) Action) Action {
+	if r.IsOk() {
+		return ActionAck
+	}
+	return classify(r.GetErr())
+}
+
+// 将返回 Result[Ack] 的处理函数包装为消息系统的回调：Ok 时确认消息，Err 时按分类重新投递或投递到死信队列。
+// 处理函数中的 ErrMust panic 视为 Err。返回值为 Ack/Nack/DeadLetter 本身的错误
+func Middleware[M any](h func(ctx context.Context, msg M) result.Result[Ack], opts ...Option) func(context.Context, M, Delivery) error {
+	c := config{classify: DefaultClassify}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return func(ctx context.Context, msg M, d Delivery) error {
+		var r result.Result[Ack]
+		if err := must.CatchMustPanic(func() {
+			r = h(ctx, msg)
+		}); err != nil {
+			r = result.Err[Ack](err)
+		}
+		switch Decide(r, c.classify) {
+		case ActionAck:
+			return d.Ack(ctx)
+		case ActionDeadLetter:
+			return d.DeadLetter(ctx, r.GetErr())
+		}
+		return d.Nack(ctx)
+	}
+}
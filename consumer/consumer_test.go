@@ -0,0 +1,69 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+type recorder struct {
+	actions []Action
+	cause   error
+}
+
+func (r *recorder) Ack(context.Context) error {
+	r.actions = append(r.actions, ActionAck)
+	return nil
+}
+
+func (r *recorder) Nack(context.Context) error {
+	r.actions = append(r.actions, ActionNack)
+	return nil
+}
+
+func (r *recorder) DeadLetter(_ context.Context, cause error) error {
+	r.actions = append(r.actions, ActionDeadLetter)
+	r.cause = cause
+	return nil
+}
+
+var errMalformed = errors.New("malformed payload")
+
+func handle(_ context.Context, msg string) result.Result[Ack] {
+	switch msg {
+	case "ok":
+		return result.Ok(Ack{})
+	case "bad":
+		return result.Err[Ack](Permanent(fmt.Errorf("decode: %w", errMalformed)))
+	}
+	return result.Err[Ack](errors.New("database unavailable"))
+}
+
+func TestMiddleware(t *testing.T) {
+	h := Middleware(handle)
+	d := &recorder{}
+	for _, msg := range []string{"ok", "bad", "retry"} {
+		if err := h(context.Background(), msg, d); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	want := []Action{ActionAck, ActionDeadLetter, ActionNack}
+	if fmt.Sprint(d.actions) != fmt.Sprint(want) {
+		t.Errorf("Expected %v, got %v", want, d.actions)
+	}
+	if !errors.Is(d.cause, errMalformed) {
+		t.Errorf("Expected the dead-letter cause to wrap the original error, got %v", d.cause)
+	}
+}
+
+func TestMiddleware_Classifier(t *testing.T) {
+	h := Middleware(handle, WithClassifier(func(error) Action { return ActionDeadLetter }))
+	d := &recorder{}
+	h(context.Background(), "retry", d)
+	if len(d.actions) != 1 || d.actions[0] != ActionDeadLetter {
+		t.Errorf("Expected the custom classifier to dead-letter, got %v", d.actions)
+	}
+}
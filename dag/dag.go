@@ -0,0 +1,188 @@
+package dag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/result"
+)
+
+var (
+	ErrDuplicateTask = errors.New("duplicate task")
+	ErrUnknownDep    = errors.New("unknown dependency")
+	ErrCycle         = errors.New("dependency cycle")
+)
+
+// 因为依赖的任务失败而被跳过的任务返回的错误
+type SkippedError struct {
+	Task string // 被跳过的任务
+	Dep  string // 失败的依赖
+}
+
+func (e *SkippedError) Error() string {
+	return fmt.Sprintf("task %q skipped: dependency %q failed", e.Task, e.Dep)
+}
+
+type task struct {
+	name string
+	deps []string
+	run  func(context.Context) result.Result[any]
+}
+
+// 按依赖顺序执行任务的运行器
+type Runner struct {
+	parallelism int
+	tasks       []*task
+	byName      map[string]*task
+	errs        []error
+}
+
+// 创建最多同时执行 parallelism 个任务的运行器，parallelism <= 0 时不限制
+func New(parallelism int) *Runner {
+	return &Runner{parallelism: parallelism, byName: make(map[string]*task)}
+}
+
+// 添加一个任务，deps 为其依赖的任务名
+func (r *Runner) Add(name string, deps []string, run func(context.Context) result.Result[any]) *Runner {
+	if _, ok := r.byName[name]; ok {
+		r.errs = append(r.errs, fmt.Errorf("%w: %s", ErrDuplicateTask, name))
+		return r
+	}
+	t := &task{name: name, deps: deps, run: run}
+	r.tasks = append(r.tasks, t)
+	r.byName[name] = t
+	return r
+}
+
+// 检查依赖是否存在以及是否有环
+func (r *Runner) validate() error {
+	errs := slices.Clone(r.errs)
+	for _, t := range r.tasks {
+		for _, d := range t.deps {
+			if _, ok := r.byName[d]; !ok {
+				errs = append(errs, fmt.Errorf("%w: %s -> %s", ErrUnknownDep, t.name, d))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[string]int)
+	var visit func(t *task) error
+	visit = func(t *task) error {
+		switch state[t.name] {
+		case visiting:
+			return fmt.Errorf("%w at %s", ErrCycle, t.name)
+		case done:
+			return nil
+		}
+		state[t.name] = visiting
+		for _, d := range t.deps {
+			if err := visit(r.byName[d]); err != nil {
+				return err
+			}
+		}
+		state[t.name] = done
+		return nil
+	}
+	for _, t := range r.tasks {
+		if err := visit(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 按拓扑顺序执行所有任务，返回任务名到其结果的映射。
+// 依赖失败（或被跳过）的任务不会执行，其结果为 *SkippedError。
+// 依赖不存在或存在环时返回 Err
+func (r *Runner) Run(ctx context.Context) result.Result[map[string]result.Result[any]] {
+	if err := r.validate(); err != nil {
+		return result.Err[map[string]result.Result[any]](err)
+	}
+
+	dependents := make(map[string][]*task)
+	pending := make(map[string]int)
+	for _, t := range r.tasks {
+		pending[t.name] = len(t.deps)
+		for _, d := range t.deps {
+			dependents[d] = append(dependents[d], t)
+		}
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]result.Result[any], len(r.tasks))
+		slots   chan struct{}
+	)
+	if r.parallelism > 0 {
+		slots = make(chan struct{}, r.parallelism)
+	}
+
+	var schedule func(t *task)
+	// 记录 t 的结果，并调度依赖全部完成的任务。调用时需持有 mu
+	var finish func(t *task, res result.Result[any])
+	finish = func(t *task, res result.Result[any]) {
+		results[t.name] = res
+		for _, next := range dependents[t.name] {
+			pending[next.name]--
+			if pending[next.name] == 0 {
+				schedule(next)
+			}
+		}
+	}
+	// 调度任务 t，依赖失败时直接标记为跳过。调用时需持有 mu
+	schedule = func(t *task) {
+		for _, d := range t.deps {
+			if results[d].IsErr() {
+				finish(t, result.Err[any](&SkippedError{Task: t.name, Dep: d}))
+				return
+			}
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if slots != nil {
+				slots <- struct{}{}
+				defer func() { <-slots }()
+			}
+			res := runTask(ctx, t)
+			mu.Lock()
+			defer mu.Unlock()
+			finish(t, res)
+		}()
+	}
+
+	mu.Lock()
+	for _, t := range r.tasks {
+		if len(t.deps) == 0 {
+			schedule(t)
+		}
+	}
+	mu.Unlock()
+	wg.Wait()
+	return result.Ok(results)
+}
+
+func runTask(ctx context.Context, t *task) result.Result[any] {
+	if err := ctx.Err(); err != nil {
+		return result.Err[any](err)
+	}
+	var res result.Result[any]
+	if err := must.CatchMustPanic(func() {
+		res = t.run(ctx)
+	}); err != nil {
+		return result.Err[any](err)
+	}
+	return res
+}
@@ -0,0 +1,75 @@
+package dag
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestRunner_Order(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	step := func(name string) func(context.Context) result.Result[any] {
+		return func(context.Context) result.Result[any] {
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			return result.Ok[any](name)
+		}
+	}
+	r := New(2).
+		Add("deploy", []string{"build", "test"}, step("deploy")).
+		Add("build", nil, step("build")).
+		Add("test", []string{"build"}, step("test")).
+		Run(context.Background())
+
+	results := r.Get()
+	if len(results) != 3 || !results["deploy"].Has("deploy") {
+		t.Errorf("Unexpected results: %v", results)
+	}
+	if !slices.Equal(order, []string{"build", "test", "deploy"}) {
+		t.Errorf("Expected topological order, got %v", order)
+	}
+}
+
+func TestRunner_Skip(t *testing.T) {
+	errBuild := errors.New("build failed")
+	called := false
+	results := New(0).
+		Add("build", nil, func(context.Context) result.Result[any] { return result.Err[any](errBuild) }).
+		Add("test", []string{"build"}, func(context.Context) result.Result[any] {
+			called = true
+			return result.Ok[any](nil)
+		}).
+		Add("deploy", []string{"test"}, func(context.Context) result.Result[any] { return result.Ok[any](nil) }).
+		Add("lint", nil, func(context.Context) result.Result[any] { return result.Ok[any]("ok") }).
+		Run(context.Background()).Get()
+
+	if called {
+		t.Error("Expected dependent of a failed task not to run")
+	}
+	if !results["build"].HasErr(errBuild) || !results["lint"].Has("ok") {
+		t.Errorf("Unexpected results: %v", results)
+	}
+	var skipped *SkippedError
+	if !results["deploy"].HasErrAs(&skipped) || skipped.Dep != "test" {
+		t.Errorf("Expected deploy to be skipped because of test, got %v", results["deploy"])
+	}
+}
+
+func TestRunner_Invalid(t *testing.T) {
+	noop := func(context.Context) result.Result[any] { return result.Ok[any](nil) }
+	if r := New(1).Add("a", []string{"b"}, noop).Add("b", []string{"a"}, noop).Run(context.Background()); !r.HasErr(ErrCycle) {
+		t.Errorf("Expected ErrCycle, got %v", r)
+	}
+	if r := New(1).Add("a", []string{"x"}, noop).Run(context.Background()); !r.HasErr(ErrUnknownDep) {
+		t.Errorf("Expected ErrUnknownDep, got %v", r)
+	}
+	if r := New(1).Add("a", nil, noop).Add("a", nil, noop).Run(context.Background()); !r.HasErr(ErrDuplicateTask) {
+		t.Errorf("Expected ErrDuplicateTask, got %v", r)
+	}
+}
@@ -0,0 +1,61 @@
+package option
+
+import (
+	"reflect"
+	"sync"
+)
+
+// ========================== 默认值 =============================
+
+// 按类型注册的默认值集合，零值可以直接使用，可以安全地并发访问
+type Defaults struct {
+	providers sync.Map // reflect.Type -> func() any
+}
+
+// 包级别的默认值集合，由 RegisterDefault 和 GetOrDefault 使用
+var globalDefaults Defaults
+
+// 在 d 中将 T 的默认值设置为 v，d 为 nil 时使用包级别的集合
+func SetDefault[T any](d *Defaults, v T) {
+	SetDefaultFunc(d, func() T { return v })
+}
+
+// 在 d 中将 T 的默认值设置为每次调用 f 的结果，适用于切片、map 等需要每次返回新值的类型
+func SetDefaultFunc[T any](d *Defaults, f func() T) {
+	if d == nil {
+		d = &globalDefaults
+	}
+	d.providers.Store(reflect.TypeFor[T](), func() any { return f() })
+}
+
+// 在包级别的集合中注册 T 的默认值
+func RegisterDefault[T any](v T) {
+	SetDefault(nil, v)
+}
+
+// 返回 d 中 T 的默认值，未注册时返回 Nul。d 为 nil 时使用包级别的集合
+func DefaultOf[T any](d *Defaults) Option[T] {
+	if d == nil {
+		d = &globalDefaults
+	}
+	p, ok := d.providers.Load(reflect.TypeFor[T]())
+	if !ok {
+		return Nul[T]()
+	}
+	// T 为接口类型时提供者可能返回 nil，此时使用零值
+	v, _ := p.(func() any)().(T)
+	return Val(v)
+}
+
+// 存在值时返回该值，否则返回包级别集合中 T 的默认值，未注册时返回零值
+func (o Option[T]) GetOrDefault() T {
+	return o.GetOrDefaultIn(nil)
+}
+
+// 存在值时返回该值，否则返回 d 中 T 的默认值，未注册时返回零值
+func (o Option[T]) GetOrDefaultIn(d *Defaults) T {
+	if o.IsVal() {
+		return o.Get()
+	}
+	return DefaultOf[T](d).GetOrZero()
+}
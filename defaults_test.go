@@ -0,0 +1,42 @@
+package option
+
+import (
+	"testing"
+	"time"
+)
+
+type timeout time.Duration
+
+func TestDefaults(t *testing.T) {
+	RegisterDefault(timeout(30 * time.Second))
+	if got := Nul[timeout]().GetOrDefault(); got != timeout(30*time.Second) {
+		t.Errorf("Expected the registered default, got %v", got)
+	}
+	if got := Val(timeout(time.Second)).GetOrDefault(); got != timeout(time.Second) {
+		t.Errorf("Expected the value itself, got %v", got)
+	}
+
+	var d Defaults
+	SetDefault(&d, timeout(5*time.Second))
+	if got := Nul[timeout]().GetOrDefaultIn(&d); got != timeout(5*time.Second) {
+		t.Errorf("Expected the scoped default, got %v", got)
+	}
+	calls := 0
+	SetDefaultFunc(&d, func() []string { calls++; return []string{"a"} })
+	Nul[[]string]().GetOrDefaultIn(&d)
+	Nul[[]string]().GetOrDefaultIn(&d)
+	if calls != 2 {
+		t.Errorf("Expected the provider to be called each time, got %d", calls)
+	}
+	if DefaultOf[int](&d).IsVal() || Nul[int]().GetOrDefaultIn(&d) != 0 {
+		t.Error("Expected unregistered types to fall back to the zero value")
+	}
+}
+
+func TestDefaults_NilInterface(t *testing.T) {
+	var d Defaults
+	SetDefaultFunc(&d, func() error { return nil })
+	if o := DefaultOf[error](&d); !o.IsVal() || o.Get() != nil {
+		t.Errorf("Expected Val(nil), got %v", o)
+	}
+}
@@ -0,0 +1,60 @@
+package option
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/viocha/go-option/tuple"
+)
+
+// ========================== 结构体差异 =============================
+
+// 一对值，与 tuple.Pair 相同
+type Pair[A, B any] = tuple.Pair[A, B]
+
+// 可以在包内按 any 类型读取的 Option
+type anyGetter interface {
+	toAny() Option[any]
+}
+
+func (o Option[T]) toAny() Option[any] {
+	if o.IsNul() {
+		return Nul[any]()
+	}
+	return Val[any](o.Get())
+}
+
+// 比较两个相同类型的结构体（或结构体指针），返回值不同的导出字段，键为字段名。
+// Option 字段按其是否存在值及内部值进行比较，nil 指针字段视为 Nul，其余字段视为 Val。
+// a 和 b 类型不同或不是结构体时 panic
+func Diff(a, b any) map[string]Pair[Option[any], Option[any]] {
+	va, vb := reflect.Indirect(reflect.ValueOf(a)), reflect.Indirect(reflect.ValueOf(b))
+	if va.Kind() != reflect.Struct || va.Type() != vb.Type() {
+		panic(fmt.Sprintf("Diff() called with %T and %T, expected two structs of the same type", a, b))
+	}
+	diff := make(map[string]Pair[Option[any], Option[any]])
+	for i := range va.NumField() {
+		f := va.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		oa, ob := fieldOption(va.Field(i)), fieldOption(vb.Field(i))
+		if oa.IsVal() != ob.IsVal() || (oa.IsVal() && !reflect.DeepEqual(oa.Get(), ob.Get())) {
+			diff[f.Name] = Pair[Option[any], Option[any]]{First: oa, Second: ob}
+		}
+	}
+	return diff
+}
+
+func fieldOption(v reflect.Value) Option[any] {
+	if g, ok := v.Interface().(anyGetter); ok {
+		return g.toAny()
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return Nul[any]()
+		}
+		return Val(v.Elem().Interface())
+	}
+	return Val(v.Interface())
+}
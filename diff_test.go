@@ -0,0 +1,45 @@
+package option
+
+import "testing"
+
+type diffConfig struct {
+	Name    string
+	Port    Option[int]
+	Timeout Option[int]
+	Proxy   *string
+	Tags    []string
+	secret  string
+}
+
+func TestDiff(t *testing.T) {
+	proxy := "p"
+	a := diffConfig{Name: "a", Port: Val(0), Timeout: Val(1), Tags: []string{"x"}, secret: "1"}
+	b := diffConfig{Name: "a", Port: Nul[int](), Timeout: Val(2), Proxy: &proxy, Tags: []string{"x"}, secret: "2"}
+
+	diff := Diff(a, &b)
+	if len(diff) != 3 {
+		t.Fatalf("Expected 3 changed fields, got %v", diff)
+	}
+	if d := diff["Port"]; !d.First.Has(0) || d.Second.IsVal() {
+		t.Errorf("Expected Port to change from Some(0) to None, got %v", d)
+	}
+	if d := diff["Timeout"]; !d.First.Has(1) || !d.Second.Has(2) {
+		t.Errorf("Expected Timeout to change from 1 to 2, got %v", d)
+	}
+	if d := diff["Proxy"]; d.First.IsVal() || !d.Second.Has("p") {
+		t.Errorf("Expected Proxy to change from None to Some(p), got %v", d)
+	}
+
+	if diff := Diff(a, a); len(diff) != 0 {
+		t.Errorf("Expected no differences, got %v", diff)
+	}
+}
+
+func TestDiff_Panic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic for mismatched types")
+		}
+	}()
+	Diff(diffConfig{}, 1)
+}
@@ -0,0 +1,279 @@
+package dto
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+var ErrTarget = errors.New("dto target must be a non-nil pointer to struct")
+
+type converter func(any) (any, error)
+
+// 结构体映射器，在使用 Option/Result 字段的领域结构体与使用指针/零值的 DTO 结构体之间复制字段
+type Mapper struct {
+	fields map[string]converter
+	types  map[[2]reflect.Type]converter
+}
+
+type MapperOption func(*Mapper)
+
+// 为目标结构体中名为 name 的字段指定转换函数，f 接收源字段中的值（不存在值时为 nil），
+// 返回值可以直接赋值给目标字段，或者作为目标字段内部的值（返回 nil 表示不存在值）
+func WithField(name string, f func(any) (any, error)) MapperOption {
+	return func(m *Mapper) {
+		m.fields[name] = f
+	}
+}
+
+// 注册从 From 到 To 的类型转换函数，优先于默认的转换规则
+func WithConverter[From, To any](f func(From) (To, error)) MapperOption {
+	key := [2]reflect.Type{reflect.TypeFor[From](), reflect.TypeFor[To]()}
+	return func(m *Mapper) {
+		m.types[key] = func(v any) (any, error) {
+			return f(v.(From))
+		}
+	}
+}
+
+func New(opts ...MapperOption) *Mapper {
+	m := &Mapper{
+		fields: make(map[string]converter),
+		types:  make(map[[2]reflect.Type]converter),
+	}
+	for _, o := range opts {
+		o(m)
+	}
+	return m
+}
+
+// 使用默认的映射器复制字段
+func Map(dst, src any) result.Result[struct{}] {
+	return New().Map(dst, src)
+}
+
+// 将 src（结构体或结构体指针）中的字段按名称复制到 dst 指向的结构体，
+// 任意一侧的字段都可以通过 `dto:"name"` 标签指定映射名称，`dto:"-"` 跳过该字段。
+// 使用 `dto:"name,valid=XValid"` 为字段指定伴随的 bool 字段后按 (值, 是否存在) 处理：
+// 读取时伴随字段为 false 视为不存在值，写入时同时设置伴随字段。未在标签中指定的 bool 字段按普通字段复制。
+//
+// 源字段中 Nul、Err 和 nil 指针视为不存在值，其余视为存在值；
+// 目标字段为 Option 时设置为 Nul/Val，为指针时设置为 nil/指向值的指针，其余字段设置为零值/值。
+// 目标字段为 Result 时只能通过 WithField 返回一个 Result 值来设置。
+// 所有字段的错误会被合并后返回
+func (m *Mapper) Map(dst, src any) result.Result[struct{}] {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return result.Err[struct{}](ErrTarget)
+	}
+	sv := reflect.Indirect(reflect.ValueOf(src))
+	if sv.Kind() != reflect.Struct {
+		return result.Err[struct{}](fmt.Errorf("dto source must be a struct, got %T", src))
+	}
+	if err := m.mapStruct(dv.Elem(), sv); err != nil {
+		return result.Err[struct{}](err)
+	}
+	return result.Ok(struct{}{})
+}
+
+func (m *Mapper) mapStruct(dv, sv reflect.Value) error {
+	srcFields, err := fieldsOf(sv.Type())
+	if err != nil {
+		return err
+	}
+	dstFields, err := fieldsOf(dv.Type())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for name, df := range dstFields {
+		sf, ok := srcFields[name]
+		if !ok {
+			continue
+		}
+		val := read(sv.Field(sf.index))
+		if sf.valid >= 0 && !sv.Field(sf.valid).Bool() {
+			val = opt.Nul[reflect.Value]()
+		}
+		present, err := m.mapField(dv.Field(df.index), val, df.name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %w", df.name, err))
+			continue
+		}
+		if df.valid >= 0 {
+			dv.Field(df.valid).SetBool(present)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type field struct {
+	name  string
+	index int
+	valid int // 标签中 valid= 指定的伴随 bool 字段的下标，没有时为 -1
+}
+
+var fieldCache sync.Map // reflect.Type -> map[string]field
+
+// 按映射名称索引导出字段，映射名称为 dto 标签中的名称（没有时为字段名）。
+// 被 valid= 指定为伴随字段的 bool 字段不会被单独索引。结果按类型缓存
+func fieldsOf(t reflect.Type) (map[string]field, error) {
+	if fields, ok := fieldCache.Load(t); ok {
+		return fields.(map[string]field), nil
+	}
+	companions := make(map[int]bool)
+	fields := make(map[string]field)
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		fd := field{name: f.Name, index: i, valid: -1}
+		if tag, ok := f.Tag.Lookup("dto"); ok {
+			if tag == "-" {
+				continue
+			}
+			tagName, opts, _ := strings.Cut(tag, ",")
+			if tagName != "" {
+				name = tagName
+			}
+			for o := range strings.SplitSeq(opts, ",") {
+				valid, ok := strings.CutPrefix(o, "valid=")
+				if !ok {
+					continue
+				}
+				c, ok := t.FieldByName(valid)
+				if !ok || !c.IsExported() || c.Type.Kind() != reflect.Bool || len(c.Index) != 1 {
+					return nil, fmt.Errorf("%v.%s: valid field %s must be an exported bool field", t, f.Name, valid)
+				}
+				fd.valid = c.Index[0]
+				companions[fd.valid] = true
+			}
+		}
+		fields[name] = fd
+	}
+	for name, fd := range fields {
+		if companions[fd.index] {
+			delete(fields, name)
+		}
+	}
+	fieldCache.Store(t, fields)
+	return fields, nil
+}
+
+// 将 val 写入目标字段，返回写入后目标字段是否存在值
+func (m *Mapper) mapField(dst reflect.Value, val opt.Option[reflect.Value], name string) (bool, error) {
+	if f, ok := m.fields[name]; ok {
+		var in any
+		if val.IsVal() {
+			in = val.Get().Interface()
+		}
+		v, err := f(in)
+		if err != nil {
+			return false, err
+		}
+		rv := reflect.ValueOf(v)
+		if rv.IsValid() && rv.Type().AssignableTo(dst.Type()) {
+			dst.Set(rv)
+			return read(dst).IsVal(), nil
+		}
+		val = opt.Nul[reflect.Value]()
+		if rv.IsValid() {
+			val = opt.Val(rv)
+		}
+	}
+	return val.IsVal(), m.write(dst, val)
+}
+
+// 读取源字段中的值
+func read(v reflect.Value) opt.Option[reflect.Value] {
+	for _, present := range []string{"IsVal", "IsOk"} {
+		if isVal := v.MethodByName(present); isVal.IsValid() && v.MethodByName("Get").IsValid() {
+			if !isVal.Call(nil)[0].Bool() {
+				return opt.Nul[reflect.Value]()
+			}
+			return opt.Val(v.MethodByName("Get").Call(nil)[0])
+		}
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return opt.Nul[reflect.Value]()
+		}
+		return opt.Val(v.Elem())
+	}
+	return opt.Val(v)
+}
+
+// 将值写入目标字段
+func (m *Mapper) write(dst reflect.Value, val opt.Option[reflect.Value]) error {
+	if scanner, ok := dst.Addr().Interface().(sql.Scanner); ok {
+		if val.IsNul() {
+			return scanner.Scan(nil)
+		}
+		get, ok := dst.Type().MethodByName("Get")
+		if !ok {
+			return scanner.Scan(val.Get().Interface())
+		}
+		v, err := m.convert(val.Get(), get.Type.Out(0))
+		if err != nil {
+			return err
+		}
+		return scanner.Scan(v.Interface())
+	}
+	if isResult(dst.Type()) {
+		return fmt.Errorf("cannot assign to %v without a field converter", dst.Type())
+	}
+	if val.IsNul() {
+		dst.SetZero()
+		return nil
+	}
+	typ := dst.Type()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	v, err := m.convert(val.Get(), typ)
+	if err != nil {
+		return err
+	}
+	if dst.Kind() == reflect.Pointer {
+		ptr := reflect.New(typ)
+		ptr.Elem().Set(v)
+		v = ptr
+	}
+	dst.Set(v)
+	return nil
+}
+
+// 将 v 转换为 typ 类型
+func (m *Mapper) convert(v reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	if c, ok := m.types[[2]reflect.Type{v.Type(), typ}]; ok {
+		out, err := c(v.Interface())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(out), nil
+	}
+	switch {
+	case v.Type().AssignableTo(typ):
+		return v, nil
+	case v.Kind() == reflect.Struct && typ.Kind() == reflect.Struct:
+		out := reflect.New(typ).Elem()
+		return out, m.mapStruct(out, v)
+	case v.Type().ConvertibleTo(typ) && (typ.Kind() != reflect.String || v.Kind() == reflect.String):
+		// 不使用整数到字符串的 rune 转换
+		return v.Convert(typ), nil
+	}
+	return reflect.Value{}, fmt.Errorf("cannot convert %v to %v", v.Type(), typ)
+}
+
+func isResult(typ reflect.Type) bool {
+	_, ok := typ.MethodByName("IsOk")
+	return ok && typ.Kind() == reflect.Struct
+}
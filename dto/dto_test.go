@@ -0,0 +1,149 @@
+package dto
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+type address struct {
+	City opt.Option[string]
+}
+
+type user struct {
+	Name    string
+	Age     opt.Option[int]
+	Email   opt.Option[string]
+	Score   result.Result[float64]
+	Address opt.Option[address]
+}
+
+type addressDTO struct {
+	City *string
+}
+
+type userDTO struct {
+	Name    string
+	Age     *int64
+	Mail    string `dto:"Email"`
+	Score   *float64
+	Address *addressDTO
+}
+
+func TestMap_DomainToDTO(t *testing.T) {
+	u := user{
+		Name:    "alice",
+		Age:     opt.Val(30),
+		Score:   result.Err[float64](errors.New("not computed")),
+		Address: opt.Val(address{City: opt.Val("Paris")}),
+	}
+	var d userDTO
+	if r := Map(&d, u); r.IsErr() {
+		t.Fatalf("Unexpected error: %v", r.GetErr())
+	}
+	if d.Name != "alice" || d.Age == nil || *d.Age != 30 || d.Mail != "" || d.Score != nil {
+		t.Errorf("Unexpected DTO: %+v", d)
+	}
+	if d.Address == nil || d.Address.City == nil || *d.Address.City != "Paris" {
+		t.Errorf("Expected nested address to be converted, got %+v", d.Address)
+	}
+}
+
+func TestMap_DTOToDomain(t *testing.T) {
+	age := int64(41)
+	d := userDTO{Name: "bob", Age: &age, Mail: "bob@example.com"}
+	errMissing := errors.New("missing score")
+	m := New(WithField("Score", func(v any) (any, error) {
+		if v == nil {
+			return result.Err[float64](errMissing), nil
+		}
+		return result.Ok(v.(float64)), nil
+	}))
+	var u user
+	if r := m.Map(&u, &d); r.IsErr() {
+		t.Fatalf("Unexpected error: %v", r.GetErr())
+	}
+	if u.Name != "bob" || !u.Age.Has(41) || !u.Email.Has("bob@example.com") || u.Address.IsVal() || !u.Score.HasErr(errMissing) {
+		t.Errorf("Unexpected domain value: %+v", u)
+	}
+
+	score := 9.5
+	d.Score = &score
+	if r := m.Map(&u, &d); r.IsErr() || !u.Score.Has(9.5) {
+		t.Errorf("Expected the field converter to set Score, got %v, %v", r, u.Score)
+	}
+}
+
+func TestMap_Converters(t *testing.T) {
+	type src struct{ ID opt.Option[int] }
+	type dst struct{ ID string }
+
+	var d dst
+	if r := Map(&d, src{ID: opt.Val(7)}); r.IsOk() {
+		t.Errorf("Expected an error without a converter, got %v", d)
+	}
+	m := New(WithConverter(func(n int) (string, error) { return "#" + strconv.Itoa(n), nil }))
+	if r := m.Map(&d, src{ID: opt.Val(7)}); r.IsErr() || d.ID != "#7" {
+		t.Errorf("Expected #7, got %q (%v)", d.ID, r)
+	}
+	if r := Map(d, src{}); !r.HasErr(ErrTarget) {
+		t.Errorf("Expected ErrTarget, got %v", r)
+	}
+}
+
+type legacyUser struct {
+	Name      string `dto:",valid=NameValid"`
+	NameValid bool
+	Age       int `dto:"Age,valid=HasAge"`
+	HasAge    bool
+}
+
+type modelUser struct {
+	Name opt.Option[string]
+	Age  opt.Option[int]
+}
+
+func TestMap_CompanionFields(t *testing.T) {
+	var m modelUser
+	if r := Map(&m, legacyUser{Name: "a", NameValid: true, Age: 0}); r.IsErr() {
+		t.Fatal(r.GetErr())
+	}
+	if !m.Name.Has("a") || m.Age.IsVal() {
+		t.Errorf("Expected companion flags to decide presence, got %+v", m)
+	}
+
+	var l legacyUser
+	if r := Map(&l, modelUser{Age: opt.Val(3)}); r.IsErr() {
+		t.Fatal(r.GetErr())
+	}
+	if l != (legacyUser{Age: 3, HasAge: true}) {
+		t.Errorf("Expected companion flags to be set, got %+v", l)
+	}
+}
+
+func TestMap_BoolFieldsWithoutTag(t *testing.T) {
+	type flags struct {
+		Name    string
+		NameSet bool
+	}
+	var d flags
+	if r := Map(&d, flags{Name: "a"}); r.IsErr() {
+		t.Fatal(r.GetErr())
+	}
+	if d != (flags{Name: "a"}) {
+		t.Errorf("Expected bool fields to be copied as plain fields, got %+v", d)
+	}
+}
+
+func TestMap_InvalidCompanion(t *testing.T) {
+	type bad struct {
+		Name string `dto:",valid=Missing"`
+	}
+	var d bad
+	if r := Map(&d, modelUser{}); r.IsOk() {
+		t.Error("Expected an error for a missing valid field")
+	}
+}
@@ -0,0 +1,55 @@
+package option
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+)
+
+// ========================== gob 与二进制编码 =============================
+
+var ErrInvalidEncoding = errors.New("invalid Option encoding")
+
+// 不存在值时为 true。encoding/json 的 omitzero 以及其他编解码库的 omitempty 据此省略 Nul 字段
+func (o Option[T]) IsZero() bool {
+	return o.IsNul()
+}
+
+// 实现 gob.GobEncoder：首字节表示是否存在值，存在值时其后为该值的 gob 编码
+func (o Option[T]) GobEncode() ([]byte, error) {
+	if o.IsNul() {
+		return []byte{0}, nil
+	}
+	buf := bytes.NewBuffer([]byte{1})
+	if err := gob.NewEncoder(buf).Encode(&o.val); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// 实现 gob.GobDecoder
+func (o *Option[T]) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidEncoding
+	}
+	if data[0] == 0 {
+		*o = Nul[T]()
+		return nil
+	}
+	var v T
+	if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&v); err != nil {
+		return err
+	}
+	*o = Val(v)
+	return nil
+}
+
+// 实现 encoding.BinaryMarshaler，格式与 GobEncode 相同
+func (o Option[T]) MarshalBinary() ([]byte, error) {
+	return o.GobEncode()
+}
+
+// 实现 encoding.BinaryUnmarshaler
+func (o *Option[T]) UnmarshalBinary(data []byte) error {
+	return o.GobDecode(data)
+}
@@ -0,0 +1,67 @@
+package option
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+type cacheEntry struct {
+	Name  Option[string]
+	Score Option[float64]
+	Tags  Option[[]string]
+}
+
+func TestGob(t *testing.T) {
+	in := cacheEntry{Name: Val(""), Tags: Val([]string{"a"})}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out cacheEntry
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Name.Has("") || out.Score.IsVal() || !out.Tags.Has([]string{"a"}) {
+		t.Errorf("Unexpected round trip: %+v", out)
+	}
+}
+
+func TestBinary(t *testing.T) {
+	data, err := Val(42).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var o Option[int]
+	if err := o.UnmarshalBinary(data); err != nil || !o.Has(42) {
+		t.Errorf("Expected Some(42), got %v (%v)", o, err)
+	}
+	if err := o.UnmarshalBinary(nil); err != ErrInvalidEncoding {
+		t.Errorf("Expected ErrInvalidEncoding, got %v", err)
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	type patch struct {
+		Name Option[string] `json:"name,omitzero"`
+	}
+	data, err := json.Marshal(patch{})
+	if err != nil || string(data) != "{}" {
+		t.Errorf("Expected omitzero to drop None, got %s (%v)", data, err)
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var o Option[int]
+	if o != Nul[int]() || o.IsVal() || !o.IsZero() || o.GetOr(7) != 7 {
+		t.Errorf("Expected the zero Option to be Nul, got %v", o)
+	}
+	if o.String() != Nul[int]().String() {
+		t.Errorf("Expected %q, got %q", Nul[int]().String(), o.String())
+	}
+	data, err := json.Marshal(o)
+	if want, _ := json.Marshal(Nul[int]()); err != nil || string(data) != string(want) {
+		t.Errorf("Expected %s, got %s (%v)", want, data, err)
+	}
+}
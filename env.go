@@ -0,0 +1,10 @@
+package option
+
+import "os"
+
+// ========================== 环境变量 =============================
+
+// 读取环境变量，未设置时返回 Nul；设置为空字符串时返回 Val("")
+func Env(name string) Option[string] {
+	return FromOk(os.LookupEnv(name))
+}
@@ -0,0 +1,13 @@
+package option
+
+import "testing"
+
+func TestEnv(t *testing.T) {
+	t.Setenv("OPTION_TEST_SET", "")
+	if o := Env("OPTION_TEST_SET"); !o.Has("") {
+		t.Errorf("Expected Val(\"\") for an empty variable, got %v", o)
+	}
+	if o := Env("OPTION_TEST_UNSET"); o.IsVal() {
+		t.Errorf("Expected Nul for an unset variable, got %v", o)
+	}
+}
@@ -0,0 +1,21 @@
+package option
+
+// ========================== 相等比较 =============================
+
+// 判断是否存在指定值，直接使用 == 比较
+func Contains[T comparable](o Option[T], value T) bool {
+	return o.IsVal() && o.Get() == value
+}
+
+// 判断两个 Option 是否相等：都不存在值，或者都存在值且值使用 == 比较相等
+func Equal[T comparable](a, b Option[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// 判断两个 Option 是否相等，使用 eq 比较存在的值
+func EqualFunc[T, U any](a Option[T], b Option[U], eq func(T, U) bool) bool {
+	if a.IsNul() || b.IsNul() {
+		return a.IsNul() == b.IsNul()
+	}
+	return eq(a.Get(), b.Get())
+}
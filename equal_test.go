@@ -0,0 +1,68 @@
+package option
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	if !Contains(Val(1), 1) || Contains(Val(1), 2) || Contains(Nul[int](), 0) {
+		t.Error("Unexpected Contains result")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	cases := []struct {
+		a, b Option[int]
+		want bool
+	}{
+		{Val(1), Val(1), true},
+		{Val(1), Val(2), false},
+		{Val(0), Nul[int](), false},
+		{Nul[int](), Nul[int](), true},
+	}
+	for _, c := range cases {
+		if got := Equal(c.a, c.b); got != c.want {
+			t.Errorf("Equal(%v, %v) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+
+	if !EqualFunc(Val("Go"), Val("go"), strings.EqualFold) {
+		t.Error("Expected EqualFunc to use the custom comparator")
+	}
+}
+
+func TestHas_FastPath(t *testing.T) {
+	type point struct{ X, Y int }
+	if !Val(point{1, 2}).Has(point{1, 2}) {
+		t.Error("Expected Has to match equal structs")
+	}
+	x, y := 1, 1
+	if !Val(&x).Has(&y) {
+		t.Error("Expected Has to keep reflect.DeepEqual semantics for pointers")
+	}
+	if !Val([]int{1}).Has([]int{1}) {
+		t.Error("Expected Has to compare slices deeply")
+	}
+}
+
+func TestOption_Comparable(t *testing.T) {
+	if Val(5) != Val(5) {
+		t.Error("Expected two Some(5) to be equal with ==")
+	}
+	if Val(0) == Nul[int]() {
+		t.Error("Expected Some(0) and None to differ")
+	}
+	var zero Option[int]
+	if zero != Nul[int]() {
+		t.Error("Expected the zero Option to equal None")
+	}
+
+	counts := map[Option[string]]int{}
+	counts[Val("a")]++
+	counts[Val("a")]++
+	counts[Nul[string]()]++
+	if counts[Val("a")] != 2 || counts[Nul[string]()] != 1 {
+		t.Errorf("Expected Option to work as a map key, got %v", counts)
+	}
+}
@@ -0,0 +1,93 @@
+package featureflag
+
+import (
+	"errors"
+	"fmt"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+var (
+	ErrFlagNotFound = errors.New("flag not found")
+)
+
+// 被评估的主体，例如用户或租户
+type Subject struct {
+	ID         string
+	Attributes map[string]any
+}
+
+// 开关评估得到的变体
+type Variant struct {
+	Name  string
+	Value any
+}
+
+// 变体值为 bool 时返回该值
+func (v Variant) AsBool() opt.Option[bool] {
+	return opt.As[bool](v.Value)
+}
+
+// 变体值为 string 时返回该值
+func (v Variant) AsString() opt.Option[string] {
+	return opt.As[string](v.Value)
+}
+
+// 开关数据的提供者
+type Provider interface {
+	Evaluate(key string, subject Subject) result.Result[Variant]
+}
+
+// 开关评估的入口，提供者不可用或返回 Err 时，评估结果为 Nul
+type Client struct {
+	provider Provider
+	onErr    func(key string, err error)
+}
+
+// provider 可以为 nil，此时所有评估结果均为 Nul
+func New(provider Provider) *Client {
+	return &Client{provider: provider}
+}
+
+// 设置评估失败时的回调，可用于记录日志
+func (c *Client) OnErr(f func(key string, err error)) *Client {
+	c.onErr = f
+	return c
+}
+
+// 评估开关，提供者不可用或评估失败时返回 Nul
+func (c *Client) Evaluate(key string, subject Subject) opt.Option[Variant] {
+	if c == nil || c.provider == nil {
+		return opt.Nul[Variant]()
+	}
+	r := result.FromFunc(func() result.Result[Variant] {
+		return c.provider.Evaluate(key, subject)
+	})
+	return result.Flatten(r).Catch(func(err error) {
+		if c.onErr != nil {
+			c.onErr(key, err)
+		}
+	}).Val()
+}
+
+// 评估 bool 开关，无法得到 bool 值时返回 fallback
+func (c *Client) BoolOr(key string, subject Subject, fallback bool) bool {
+	return opt.Then(c.Evaluate(key, subject), Variant.AsBool).GetOr(fallback)
+}
+
+// 评估 string 开关，无法得到 string 值时返回 fallback
+func (c *Client) StringOr(key string, subject Subject, fallback string) string {
+	return opt.Then(c.Evaluate(key, subject), Variant.AsString).GetOr(fallback)
+}
+
+// 基于内存的提供者，所有主体得到相同的变体
+type StaticProvider map[string]Variant
+
+func (p StaticProvider) Evaluate(key string, _ Subject) result.Result[Variant] {
+	v, ok := p[key]
+	if !ok {
+		return result.Err[Variant](fmt.Errorf("%w: %s", ErrFlagNotFound, key))
+	}
+	return result.Ok(v)
+}
@@ -0,0 +1,54 @@
+package featureflag
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestClient(t *testing.T) {
+	c := New(StaticProvider{
+		"new-ui": {Name: "on", Value: true},
+		"theme":  {Name: "dark", Value: "dark"},
+	})
+	user := Subject{ID: "u1"}
+
+	if v := c.Evaluate("new-ui", user); !v.IsVal() || v.Get().Name != "on" {
+		t.Errorf("Expected Some(on), got %v", v)
+	}
+	if !c.BoolOr("new-ui", user, false) {
+		t.Error("Expected new-ui to be enabled")
+	}
+	if c.StringOr("theme", user, "light") != "dark" {
+		t.Error("Expected dark theme")
+	}
+	if c.StringOr("new-ui", user, "light") != "light" {
+		t.Error("Expected fallback for a variant of the wrong type")
+	}
+
+	var failed string
+	c.OnErr(func(key string, err error) {
+		if errors.Is(err, ErrFlagNotFound) {
+			failed = key
+		}
+	})
+	if c.Evaluate("missing", user).IsVal() || failed != "missing" {
+		t.Errorf("Expected None and error callback for missing flag, got %q", failed)
+	}
+}
+
+type brokenProvider struct{}
+
+func (brokenProvider) Evaluate(string, Subject) result.Result[Variant] {
+	return result.Err[Variant](errors.New("provider unavailable"))
+}
+
+func TestClient_Unavailable(t *testing.T) {
+	if New(nil).Evaluate("x", Subject{}).IsVal() {
+		t.Error("Expected None without a provider")
+	}
+	if !New(brokenProvider{}).BoolOr("x", Subject{}, true) {
+		t.Error("Expected fallback when provider fails")
+	}
+}
@@ -0,0 +1,119 @@
+package option
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"reflect"
+
+	"github.com/viocha/go-option/internal/common"
+)
+
+// ========================== 命令行参数绑定 =============================
+
+var ErrBindTarget = errors.New("bind target must be a non-nil pointer to struct")
+
+// 可以通过反射设置的 Option，用于在包内按 any 类型赋值
+type anySetter interface {
+	setAny(v any) error
+	reset()
+}
+
+func (o *Option[T]) setAny(v any) error {
+	if tv, ok := v.(T); ok {
+		*o = Val(tv)
+		return nil
+	}
+	typ := reflect.TypeFor[T]()
+	rv, err := common.ConvertValue(reflect.ValueOf(v), typ)
+	if err != nil {
+		return fmt.Errorf("cannot assign %T to Option[%v]: %w", v, typ, err)
+	}
+	*o = Val(rv.Interface().(T))
+	return nil
+}
+
+func (o *Option[T]) reset() {
+	*o = Nul[T]()
+}
+
+// 将 fs 中的参数绑定到 dst 指向的结构体中带有 `flag:"name"` 标签的 Option 字段。
+// 只有在命令行中显式传入的参数才会被设置为 Val，其余字段为 Nul（即使参数值等于默认值）。
+// 需要在 fs.Parse 之后调用
+func BindFlags(fs *flag.FlagSet, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrBindTarget
+	}
+	set := make(map[string]*flag.Flag)
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = f
+	})
+
+	rv = rv.Elem()
+	for i := range rv.NumField() {
+		field := rv.Type().Field(i)
+		name, ok := field.Tag.Lookup("flag")
+		if !ok || !field.IsExported() {
+			continue
+		}
+		setter, ok := rv.Field(i).Addr().Interface().(anySetter)
+		if !ok {
+			return fmt.Errorf("field %s is not an Option", field.Name)
+		}
+		if fs.Lookup(name) == nil {
+			return fmt.Errorf("field %s: flag -%s is not defined", field.Name, name)
+		}
+		f, ok := set[name]
+		if !ok {
+			setter.reset()
+			continue
+		}
+		var v any = f.Value.String()
+		if g, ok := f.Value.(flag.Getter); ok {
+			v = g.Get()
+		}
+		if err := setter.setAny(v); err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// 在 fs 中定义一个名为 name 的参数，返回的 Option 在命令行中传入该参数后才为 Val。
+// 参数值按 encoding.TextUnmarshaler、time.Duration 和基础类型的规则解析为 T，T 为 bool 时与 flag.Bool 一样可以省略参数值；
+// fs 为 nil 时使用 flag.CommandLine
+func Flag[T any](fs *flag.FlagSet, name, usage string) *Option[T] {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	o := new(Option[T])
+	fs.Var(optionFlag[T]{o}, name, usage)
+	return o
+}
+
+// 将 Option 适配为 flag.Value，T 为 bool 时可以只写 -name 而不带参数值
+type optionFlag[T any] struct {
+	o *Option[T]
+}
+
+func (f optionFlag[T]) Set(s string) error {
+	v, err := common.ParseString(s, reflect.TypeFor[T]())
+	if err != nil {
+		return err
+	}
+	*f.o = Val(v.Interface().(T))
+	return nil
+}
+
+func (f optionFlag[T]) String() string {
+	// flag 包在输出帮助信息时会对零值调用 String
+	if f.o == nil || f.o.IsNul() {
+		return ""
+	}
+	return fmt.Sprint(f.o.Get())
+}
+
+func (f optionFlag[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
@@ -0,0 +1,113 @@
+package option
+
+import (
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+)
+
+type serveConfig struct {
+	Port    Option[int]           `flag:"port"`
+	Host    Option[string]        `flag:"host"`
+	Timeout Option[time.Duration] `flag:"timeout"`
+	Verbose Option[bool]          `flag:"v"`
+	Other   int
+}
+
+func TestBindFlags(t *testing.T) {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	fs.Int("port", 8080, "")
+	fs.String("host", "localhost", "")
+	fs.Duration("timeout", time.Second, "")
+	fs.Bool("v", false, "")
+	if err := fs.Parse([]string{"-port", "8080", "-timeout", "2s"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var cfg serveConfig
+	if err := BindFlags(fs, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.Port.Has(8080) {
+		t.Errorf("Expected explicitly passed default value to be Some(8080), got %v", cfg.Port)
+	}
+	if !cfg.Timeout.Has(2 * time.Second) {
+		t.Errorf("Expected Some(2s), got %v", cfg.Timeout)
+	}
+	if cfg.Host.IsVal() || cfg.Verbose.IsVal() {
+		t.Errorf("Expected flags not passed to be None, got %v %v", cfg.Host, cfg.Verbose)
+	}
+}
+
+func TestBindFlags_Errors(t *testing.T) {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	if err := BindFlags(fs, serveConfig{}); !errors.Is(err, ErrBindTarget) {
+		t.Errorf("Expected ErrBindTarget, got %v", err)
+	}
+	if err := BindFlags(fs, &serveConfig{}); err == nil {
+		t.Error("Expected error for undefined flag")
+	}
+}
+
+func TestBindFlags_Overflow(t *testing.T) {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	fs.Int("level", 0, "")
+	fs.Int("count", 0, "")
+	if err := fs.Parse([]string{"-level", "300", "-count", "-1"}); err != nil {
+		t.Fatal(err)
+	}
+	var small struct {
+		Level Option[int8] `flag:"level"`
+	}
+	if err := BindFlags(fs, &small); err == nil {
+		t.Errorf("Expected an error for 300 into int8, got %v", small.Level)
+	}
+	var unsigned struct {
+		Count Option[uint] `flag:"count"`
+	}
+	if err := BindFlags(fs, &unsigned); err == nil {
+		t.Errorf("Expected an error for -1 into uint, got %v", unsigned.Count)
+	}
+	var wide struct {
+		Level Option[int64] `flag:"level"`
+	}
+	if err := BindFlags(fs, &wide); err != nil || !wide.Level.Has(300) {
+		t.Errorf("Expected Val(300), got %v (%v)", wide.Level, err)
+	}
+}
+
+func TestFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	port := Flag[int](fs, "port", "")
+	timeout := Flag[time.Duration](fs, "timeout", "")
+	host := Flag[string](fs, "host", "")
+	if err := fs.Parse([]string{"-port", "8080", "-timeout", "5s"}); err != nil {
+		t.Fatal(err)
+	}
+	if !port.Has(8080) || !timeout.Has(5*time.Second) || host.IsVal() {
+		t.Errorf("Unexpected flags: %v %v %v", *port, *timeout, *host)
+	}
+	if err := fs.Parse([]string{"-port", "x"}); err == nil {
+		t.Error("Expected a parse error")
+	}
+}
+
+func TestFlag_Bool(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	verbose := Flag[bool](fs, "v", "verbose output")
+	debug := Flag[bool](fs, "debug", "")
+	if err := fs.Parse([]string{"-v", "-debug=false", "arg"}); err != nil {
+		t.Fatal(err)
+	}
+	if !verbose.Has(true) || !debug.Has(false) || fs.Arg(0) != "arg" {
+		t.Errorf("Unexpected flags: %v %v %v", *verbose, *debug, fs.Args())
+	}
+	var buf strings.Builder
+	fs.SetOutput(&buf)
+	fs.PrintDefaults()
+	if !strings.Contains(buf.String(), "verbose output") {
+		t.Errorf("Expected usage to be printed, got %q", buf.String())
+	}
+}
@@ -0,0 +1,91 @@
+package option
+
+import "cmp"
+
+// ========================== 聚合策略 =============================
+
+// 聚合时对不存在值的元素的处理策略
+type FoldPolicy int
+
+const (
+	// 忽略不存在值的元素
+	SkipMissing FoldPolicy = iota
+	// 任意元素不存在值时，聚合结果为 Nul
+	FailOnMissing
+	// 不存在值的元素视为零值
+	TreatMissingAsZero
+)
+
+// 按策略 p 将 []Option[T] 收集为 Option[[]T]，只有 FailOnMissing 会返回 Nul
+func CollectWith[T any](opts []Option[T], p FoldPolicy) Option[[]T] {
+	vals := make([]T, 0, len(opts))
+	for _, o := range opts {
+		switch {
+		case o.IsVal():
+			vals = append(vals, o.Get())
+		case p == FailOnMissing:
+			return Nul[[]T]()
+		case p == TreatMissingAsZero:
+			vals = append(vals, *new(T))
+		}
+	}
+	return Val(vals)
+}
+
+// 按策略 p 求和，没有任何值时结果为 0
+func Sum[T Number](opts []Option[T], p FoldPolicy) Option[T] {
+	return Map(CollectWith(opts, p), func(vals []T) T {
+		var sum T
+		for _, v := range vals {
+			sum += v
+		}
+		return sum
+	})
+}
+
+// 按策略 p 求积，没有任何值时结果为 1
+func Product[T Number](opts []Option[T], p FoldPolicy) Option[T] {
+	return Map(CollectWith(opts, p), func(vals []T) T {
+		prod := T(1)
+		for _, v := range vals {
+			prod *= v
+		}
+		return prod
+	})
+}
+
+// 按策略 p 求平均值，没有任何值时返回 Nul
+func Average[T Number](opts []Option[T], p FoldPolicy) Option[float64] {
+	return Then(CollectWith(opts, p), func(vals []T) Option[float64] {
+		if len(vals) == 0 {
+			return Nul[float64]()
+		}
+		var sum float64
+		for _, v := range vals {
+			sum += float64(v)
+		}
+		return Val(sum / float64(len(vals)))
+	})
+}
+
+// 按策略 p 求最小值，没有任何值时返回 Nul
+func MinWith[T cmp.Ordered](opts []Option[T], p FoldPolicy) Option[T] {
+	return Then(CollectWith(opts, p), func(vals []T) Option[T] {
+		return Min(toOptions(vals)...)
+	})
+}
+
+// 按策略 p 求最大值，没有任何值时返回 Nul
+func MaxWith[T cmp.Ordered](opts []Option[T], p FoldPolicy) Option[T] {
+	return Then(CollectWith(opts, p), func(vals []T) Option[T] {
+		return Max(toOptions(vals)...)
+	})
+}
+
+func toOptions[T any](vals []T) []Option[T] {
+	opts := make([]Option[T], len(vals))
+	for i, v := range vals {
+		opts[i] = Val(v)
+	}
+	return opts
+}
@@ -0,0 +1,47 @@
+package option
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFoldPolicy(t *testing.T) {
+	opts := []Option[int]{Val(4), Nul[int](), Val(2)}
+	cases := []struct {
+		policy              FoldPolicy
+		sum, prod, min, max Option[int]
+		avg                 Option[float64]
+		collect             Option[[]int]
+	}{
+		{SkipMissing, Val(6), Val(8), Val(2), Val(4), Val(3.0), Val([]int{4, 2})},
+		{FailOnMissing, Nul[int](), Nul[int](), Nul[int](), Nul[int](), Nul[float64](), Nul[[]int]()},
+		{TreatMissingAsZero, Val(6), Val(0), Val(0), Val(4), Val(2.0), Val([]int{4, 0, 2})},
+	}
+	for _, c := range cases {
+		if got := Sum(opts, c.policy); got != c.sum {
+			t.Errorf("Sum(%v) = %v, want %v", c.policy, got, c.sum)
+		}
+		if got := Product(opts, c.policy); got != c.prod {
+			t.Errorf("Product(%v) = %v, want %v", c.policy, got, c.prod)
+		}
+		if got := Average(opts, c.policy); got != c.avg {
+			t.Errorf("Average(%v) = %v, want %v", c.policy, got, c.avg)
+		}
+		if got := MinWith(opts, c.policy); got != c.min {
+			t.Errorf("MinWith(%v) = %v, want %v", c.policy, got, c.min)
+		}
+		if got := MaxWith(opts, c.policy); got != c.max {
+			t.Errorf("MaxWith(%v) = %v, want %v", c.policy, got, c.max)
+		}
+		got := CollectWith(opts, c.policy)
+		if got.IsVal() != c.collect.IsVal() || !slices.Equal(got.GetOrZero(), c.collect.GetOrZero()) {
+			t.Errorf("CollectWith(%v) = %v, want %v", c.policy, got, c.collect)
+		}
+	}
+	if got := Sum([]Option[float64]{}, SkipMissing); !got.Has(0) {
+		t.Errorf("Expected the sum of nothing to be 0, got %v", got)
+	}
+	if got := Average([]Option[int]{Nul[int]()}, SkipMissing); got.IsVal() {
+		t.Errorf("Expected the average of nothing to be None, got %v", got)
+	}
+}
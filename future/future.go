@@ -0,0 +1,171 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/result"
+)
+
+var ErrNoFutures = errors.New("no futures given")
+
+// 异步计算的结果，可以被多次等待，零值不可用
+type Future[T any] struct {
+	s *state[T]
+}
+
+type state[T any] struct {
+	once sync.Once
+	done chan struct{}
+	res  result.Result[T]
+}
+
+func newFuture[T any]() Future[T] {
+	return Future[T]{s: &state[T]{done: make(chan struct{})}}
+}
+
+// 设置结果，只有第一次调用生效，返回是否生效
+func (f Future[T]) complete(r result.Result[T]) bool {
+	completed := false
+	f.s.once.Do(func() {
+		f.s.res = r
+		completed = true
+		close(f.s.done)
+	})
+	return completed
+}
+
+// 以 err 结束，用于 Watchdog 等外部强制结束
+func (f Future[T]) fail(err error) bool {
+	return f.complete(result.Err[T](err))
+}
+
+// 在新的 goroutine 中执行 fn，fn 中的 ErrMust panic 会被转换为 Err
+func Go[T any](fn func() (T, error)) Future[T] {
+	f := newFuture[T]()
+	go func() {
+		var r result.Result[T]
+		if err := must.CatchMustPanic(func() {
+			r = result.From(fn())
+		}); err != nil {
+			r = result.Err[T](err)
+		}
+		f.complete(r)
+	}()
+	return f
+}
+
+// 结果就绪时关闭的 channel
+func (f Future[T]) Done() <-chan struct{} {
+	return f.s.done
+}
+
+// 阻塞等待结果
+func (f Future[T]) Await() result.Result[T] {
+	<-f.s.done
+	return f.s.res
+}
+
+// 等待结果，ctx 先被取消时返回 Err(context.Cause(ctx))
+func (f Future[T]) AwaitCtx(ctx context.Context) result.Result[T] {
+	select {
+	case <-f.s.done:
+		return f.s.res
+	case <-ctx.Done():
+		return result.Err[T](context.Cause(ctx))
+	}
+}
+
+// 实现 warmup.Warmable，等待结果并返回其中的错误
+func (f Future[T]) Warm(ctx context.Context) error {
+	_, err := f.AwaitCtx(ctx).Unpack()
+	return err
+}
+
+// ========================== 组合 =============================
+
+// f 为 Ok 时使用 fn 构造新的结果，Err 直接传递，fn 中的 ErrMust panic 会被转换为 Err
+func Then[T, U any](f Future[T], fn func(T) result.Result[U]) Future[U] {
+	next := newFuture[U]()
+	go func() {
+		next.complete(result.Then(f.Await(), fn))
+	}()
+	return next
+}
+
+// 所有 Future 均为 Ok 时按顺序返回所有值，任意一个为 Err 时立即返回该错误
+func All[T any](fs ...Future[T]) Future[[]T] {
+	all := newFuture[[]T]()
+	go func() {
+		vals := make([]T, len(fs))
+		results := fanIn(fs)
+		for range fs {
+			ir := <-results
+			if ir.r.IsErr() {
+				all.complete(result.Err[[]T](ir.r.GetErr()))
+				return
+			}
+			vals[ir.i] = ir.r.Get()
+		}
+		all.complete(result.Ok(vals))
+	}()
+	return all
+}
+
+// 返回最先结束的 Future 的结果，无论是 Ok 还是 Err。没有任何参数时返回 ErrNoFutures
+func Race[T any](fs ...Future[T]) Future[T] {
+	if len(fs) == 0 {
+		return resolved(result.Err[T](ErrNoFutures))
+	}
+	race := newFuture[T]()
+	go func() {
+		race.complete((<-fanIn(fs)).r)
+	}()
+	return race
+}
+
+// 返回最先为 Ok 的 Future 的结果，全部为 Err 时返回所有错误的合并。没有任何参数时返回 ErrNoFutures
+func Any[T any](fs ...Future[T]) Future[T] {
+	if len(fs) == 0 {
+		return resolved(result.Err[T](ErrNoFutures))
+	}
+	anyOk := newFuture[T]()
+	go func() {
+		errs := make([]error, len(fs))
+		results := fanIn(fs)
+		for range fs {
+			ir := <-results
+			if ir.r.IsOk() {
+				anyOk.complete(ir.r)
+				return
+			}
+			errs[ir.i] = ir.r.GetErr()
+		}
+		anyOk.complete(result.Err[T](errors.Join(errs...)))
+	}()
+	return anyOk
+}
+
+type indexedResult[T any] struct {
+	i int
+	r result.Result[T]
+}
+
+// 按结束的顺序发送每个 Future 的结果，channel 有足够的缓冲，不会阻塞发送方
+func fanIn[T any](fs []Future[T]) <-chan indexedResult[T] {
+	results := make(chan indexedResult[T], len(fs))
+	for i, f := range fs {
+		go func() {
+			results <- indexedResult[T]{i: i, r: f.Await()}
+		}()
+	}
+	return results
+}
+
+func resolved[T any](r result.Result[T]) Future[T] {
+	f := newFuture[T]()
+	f.complete(r)
+	return f
+}
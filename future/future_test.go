@@ -0,0 +1,79 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+	"github.com/viocha/go-option/util"
+)
+
+func TestGo(t *testing.T) {
+	if r := Go(func() (int, error) { return 42, nil }).Await(); !r.Has(42) {
+		t.Errorf("Expected Ok(42), got %v", r)
+	}
+	errBoom := errors.New("boom")
+	f := Go(func() (int, error) { return 0, errBoom })
+	if r := f.Await(); !r.HasErr(errBoom) {
+		t.Errorf("Expected Err(boom), got %v", r)
+	}
+	if r := f.Await(); !r.HasErr(errBoom) {
+		t.Errorf("Expected the same result on a second Await, got %v", r)
+	}
+	if r := Go(func() (int, error) { util.MustNil(errBoom); return 1, nil }).Await(); !errors.Is(r.GetErr(), errBoom) {
+		t.Errorf("Expected the must panic to become Err, got %v", r)
+	}
+}
+
+func TestAwaitCtx(t *testing.T) {
+	f := newFuture[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if r := f.AwaitCtx(ctx); !r.HasErr(context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", r)
+	}
+	f.complete(result.Ok(1))
+	if err := f.Warm(context.Background()); err != nil {
+		t.Errorf("Expected Warm to succeed, got %v", err)
+	}
+}
+
+func TestThen(t *testing.T) {
+	f := Then(Go(func() (int, error) { return 20, nil }), func(n int) result.Result[string] {
+		return result.Ok(strconv.Itoa(n + 1))
+	})
+	if r := f.Await(); !r.Has("21") {
+		t.Errorf("Expected Ok(21), got %v", r)
+	}
+}
+
+func TestAll(t *testing.T) {
+	if r := All(resolved(result.Ok(1)), Go(func() (int, error) { return 2, nil })).Await(); !r.Has([]int{1, 2}) {
+		t.Errorf("Expected Ok([1 2]), got %v", r)
+	}
+	errBoom := errors.New("boom")
+	pending := newFuture[int]()
+	if r := All(pending, resolved(result.Err[int](errBoom))).Await(); !r.HasErr(errBoom) {
+		t.Errorf("Expected All to fail fast with boom, got %v", r)
+	}
+}
+
+func TestRaceAny(t *testing.T) {
+	errBoom := errors.New("boom")
+	pending := newFuture[int]()
+	if r := Race(pending, resolved(result.Err[int](errBoom))).Await(); !r.HasErr(errBoom) {
+		t.Errorf("Expected Race to return the first result, got %v", r)
+	}
+	if r := Any(pending, resolved(result.Err[int](errBoom)), resolved(result.Ok(3))).Await(); !r.Has(3) {
+		t.Errorf("Expected Any to return the first Ok, got %v", r)
+	}
+	errOther := errors.New("other")
+	if r := Any(resolved(result.Err[int](errBoom)), resolved(result.Err[int](errOther))).Await(); !r.HasErr(errBoom) || !r.HasErr(errOther) {
+		t.Errorf("Expected all errors, got %v", r)
+	}
+	if r := Any[int]().Await(); !r.HasErr(ErrNoFutures) {
+		t.Errorf("Expected ErrNoFutures, got %v", r)
+	}
+}
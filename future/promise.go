@@ -0,0 +1,50 @@
+package future
+
+import "github.com/viocha/go-option/result"
+
+// 由外部回调设置结果的 Future，用于将基于回调的接口桥接为 Future。
+// 只有第一次 Resolve/Reject 生效，可安全地并发调用
+type Promise[T any] struct {
+	f      Future[T]
+	onLate func(result.Result[T])
+}
+
+type PromiseOption[T any] func(*Promise[T])
+
+// 结果已经设置后再次调用 Resolve/Reject 时，以被忽略的结果调用 f
+func OnLateSettle[T any](f func(result.Result[T])) PromiseOption[T] {
+	return func(p *Promise[T]) { p.onLate = f }
+}
+
+func NewPromise[T any](opts ...PromiseOption[T]) *Promise[T] {
+	p := &Promise[T]{f: newFuture[T]()}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// 以 v 结束，返回是否生效
+func (p *Promise[T]) Resolve(v T) bool {
+	return p.settle(result.Ok(v))
+}
+
+// 以 err 结束，返回是否生效
+func (p *Promise[T]) Reject(err error) bool {
+	return p.settle(result.Err[T](err))
+}
+
+func (p *Promise[T]) settle(r result.Result[T]) bool {
+	if p.f.complete(r) {
+		return true
+	}
+	if p.onLate != nil {
+		p.onLate(r)
+	}
+	return false
+}
+
+// 与该 Promise 关联的 Future
+func (p *Promise[T]) Future() Future[T] {
+	return p.f
+}
@@ -0,0 +1,39 @@
+package future
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestPromise(t *testing.T) {
+	var late []result.Result[string]
+	p := NewPromise(OnLateSettle(func(r result.Result[string]) { late = append(late, r) }))
+
+	go func() { p.Resolve("ack") }()
+	if r := p.Future().Await(); !r.Has("ack") {
+		t.Errorf("Expected Ok(ack), got %v", r)
+	}
+	errTimeout := errors.New("timeout")
+	if p.Reject(errTimeout) || p.Resolve("again") {
+		t.Error("Expected later settle attempts to be ignored")
+	}
+	if len(late) != 2 || !late[0].HasErr(errTimeout) || !late[1].Has("again") {
+		t.Errorf("Expected the late attempts to be reported, got %v", late)
+	}
+	if r := p.Future().Await(); !r.Has("ack") {
+		t.Errorf("Expected the first result to win, got %v", r)
+	}
+}
+
+func TestPromise_Reject(t *testing.T) {
+	p := NewPromise[int]()
+	errClosed := errors.New("connection closed")
+	if !p.Reject(errClosed) {
+		t.Error("Expected the first Reject to take effect")
+	}
+	if r := p.Future().Await(); !r.HasErr(errClosed) {
+		t.Errorf("Expected Err(connection closed), got %v", r)
+	}
+}
@@ -0,0 +1,129 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/result"
+)
+
+var (
+	ErrScopeClosed = errors.New("scope already waited")
+	ErrLeaked      = errors.New("goroutines outlived the scope")
+)
+
+// 结构化并发作用域：其中启动的所有任务都会在 Wait 返回前结束
+type Scope struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	failFast    bool
+	leakTimeout time.Duration
+
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	errs    []error
+	running int
+	closed  bool
+}
+
+type ScopeOption func(*Scope)
+
+// 某个任务失败时不取消其他任务，Wait 返回所有错误的合并
+func ContinueOnErr() ScopeOption {
+	return func(s *Scope) { s.failFast = false }
+}
+
+// 作用域被取消后最多等待 d，仍未结束的任务视为泄漏，Wait 返回 ErrLeaked
+func WithLeakTimeout(d time.Duration) ScopeOption {
+	return func(s *Scope) { s.leakTimeout = d }
+}
+
+// 创建一个绑定到 ctx 的作用域，默认在第一个任务失败时取消所有任务
+func NewScope(ctx context.Context, opts ...ScopeOption) *Scope {
+	ctx, cancel := context.WithCancelCause(ctx)
+	s := &Scope{ctx: ctx, cancel: cancel, failFast: true}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// 作用域的上下文，作用域被取消时随之取消
+func (s *Scope) Context() context.Context {
+	return s.ctx
+}
+
+// 在作用域中启动一个任务
+func (s *Scope) Go(f func(ctx context.Context) error) {
+	s.mu.Lock()
+	if s.closed {
+		s.errs = append(s.errs, ErrScopeClosed)
+		s.mu.Unlock()
+		return
+	}
+	s.running++
+	s.wg.Add(1)
+	s.mu.Unlock()
+
+	go func() {
+		defer s.wg.Done()
+		var err error
+		if panicErr := must.CatchMustPanic(func() {
+			err = f(s.ctx)
+		}); panicErr != nil {
+			err = panicErr
+		}
+		s.mu.Lock()
+		s.running--
+		if err != nil {
+			s.errs = append(s.errs, err)
+		}
+		s.mu.Unlock()
+		if err != nil && s.failFast {
+			s.cancel(err)
+		}
+	}()
+}
+
+// 等待所有任务结束，返回 Ok 或者所有错误的合并。Wait 之后不能再启动新任务
+func (s *Scope) Wait() result.Result[struct{}] {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	if s.leakTimeout > 0 {
+		select {
+		case <-done:
+		case <-s.ctx.Done():
+			select {
+			case <-done:
+			case <-time.After(s.leakTimeout):
+				s.mu.Lock()
+				n := s.running
+				s.errs = append(s.errs, fmt.Errorf("%w: %d still running", ErrLeaked, n))
+				s.mu.Unlock()
+			}
+		}
+	} else {
+		<-done
+	}
+	s.cancel(nil)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.errs) > 0 {
+		return result.Err[struct{}](errors.Join(s.errs...))
+	}
+	return result.Ok(struct{}{})
+}
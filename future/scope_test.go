@@ -0,0 +1,72 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScope_Ok(t *testing.T) {
+	var n atomic.Int32
+	s := NewScope(context.Background())
+	for range 5 {
+		s.Go(func(ctx context.Context) error {
+			n.Add(1)
+			return nil
+		})
+	}
+	if r := s.Wait(); !r.IsOk() || n.Load() != 5 {
+		t.Errorf("Expected Ok after 5 tasks, got %v with %d tasks", r, n.Load())
+	}
+	s.Go(func(ctx context.Context) error { return nil })
+	if r := s.Wait(); !r.HasErr(ErrScopeClosed) {
+		t.Errorf("Expected ErrScopeClosed after Wait, got %v", r)
+	}
+}
+
+func TestScope_FailFast(t *testing.T) {
+	errFail := errors.New("fail")
+	s := NewScope(context.Background())
+	s.Go(func(ctx context.Context) error { return errFail })
+	s.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+	if r := s.Wait(); !r.HasErr(errFail) {
+		t.Errorf("Expected Err(fail), got %v", r)
+	}
+}
+
+func TestScope_ContinueOnErr(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	s := NewScope(context.Background(), ContinueOnErr())
+	s.Go(func(ctx context.Context) error { return err1 })
+	s.Go(func(ctx context.Context) error {
+		time.Sleep(10 * time.Millisecond)
+		if ctx.Err() != nil {
+			t.Error("Expected context not to be canceled in ContinueOnErr mode")
+		}
+		return err2
+	})
+	if r := s.Wait(); !r.HasErr(err1) || !r.HasErr(err2) {
+		t.Errorf("Expected both errors, got %v", r)
+	}
+}
+
+func TestScope_Leak(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	defer close(release)
+	s := NewScope(ctx, WithLeakTimeout(10*time.Millisecond))
+	s.Go(func(ctx context.Context) error {
+		<-release // 忽略 ctx，模拟泄漏的任务
+		return nil
+	})
+	cancel()
+	if r := s.Wait(); !r.HasErr(ErrLeaked) {
+		t.Errorf("Expected ErrLeaked, got %v", r)
+	}
+}
@@ -0,0 +1,144 @@
+package future
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/viocha/go-option/clock"
+)
+
+// 超过最大等待时间仍未结束的 Future 被 Watchdog 强制结束时的错误
+type StalledError struct {
+	Name string
+	Age  time.Duration
+}
+
+func (e *StalledError) Error() string {
+	return fmt.Sprintf("future %q stalled after %v", e.Name, e.Age)
+}
+
+// 可以被 Watchdog 强制结束的 Future
+type watched interface {
+	Done() <-chan struct{}
+	fail(err error) bool
+}
+
+type watchEntry struct {
+	name    string
+	started time.Time
+	f       watched
+}
+
+// 监控未结束的 Future，将超过 maxAge 仍未结束的 Future 以 *StalledError 强制结束，
+// 避免生产者 goroutine 异常退出后等待者永远阻塞
+type Watchdog struct {
+	maxAge   time.Duration
+	interval time.Duration
+	onStall  func(err *StalledError, goroutines []byte)
+	clock    clock.Clock
+
+	mu      sync.Mutex
+	entries map[*watchEntry]struct{}
+}
+
+type WatchdogOption func(*Watchdog)
+
+// Future 被强制结束时调用 f，goroutines 为此时所有 goroutine 的调用栈
+func OnStall(f func(err *StalledError, goroutines []byte)) WatchdogOption {
+	return func(w *Watchdog) { w.onStall = f }
+}
+
+// 计算 Future 等待时间及 Run 检查间隔所使用的时钟，默认为系统时间
+func WithClock(c clock.Clock) WatchdogOption {
+	return func(w *Watchdog) { w.clock = c }
+}
+
+// Run 检查的时间间隔，默认为 maxAge 的四分之一
+func WithInterval(d time.Duration) WatchdogOption {
+	return func(w *Watchdog) { w.interval = d }
+}
+
+func NewWatchdog(maxAge time.Duration, opts ...WatchdogOption) *Watchdog {
+	w := &Watchdog{
+		maxAge:   maxAge,
+		interval: maxAge / 4,
+		clock:    clock.Real,
+		entries:  make(map[*watchEntry]struct{}),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// 将 f 交给 w 监控，返回 f 本身
+func Watch[F watched](w *Watchdog, name string, f F) F {
+	w.mu.Lock()
+	w.entries[&watchEntry{name: name, started: w.clock.Now(), f: f}] = struct{}{}
+	w.mu.Unlock()
+	return f
+}
+
+// 正在监控的未结束的 Future 数量
+func (w *Watchdog) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.entries)
+}
+
+// 检查一次所有被监控的 Future，移除已结束的，强制结束超时的，返回强制结束的数量
+func (w *Watchdog) Check() int {
+	now := w.clock.Now()
+	var stalled []*StalledError
+	w.mu.Lock()
+	for e := range w.entries {
+		select {
+		case <-e.f.Done():
+			delete(w.entries, e)
+			continue
+		default:
+		}
+		if age := now.Sub(e.started); age > w.maxAge {
+			delete(w.entries, e)
+			err := &StalledError{Name: e.name, Age: age}
+			if e.f.fail(err) {
+				stalled = append(stalled, err)
+			}
+		}
+	}
+	w.mu.Unlock()
+
+	if len(stalled) > 0 && w.onStall != nil {
+		dump := goroutineDump()
+		for _, err := range stalled {
+			w.onStall(err, dump)
+		}
+	}
+	return len(stalled)
+}
+
+// 定期检查，直到 ctx 被取消
+func (w *Watchdog) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.clock.After(max(w.interval, time.Millisecond)):
+			w.Check()
+		}
+	}
+}
+
+func goroutineDump() []byte {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, len(buf)*2)
+	}
+}
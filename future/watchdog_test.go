@@ -0,0 +1,62 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/viocha/go-option/clock/clocktest"
+)
+
+func TestWatchdog(t *testing.T) {
+	clk := clocktest.NewFake(time.Unix(0, 0))
+	var reported []*StalledError
+	var dump []byte
+	w := NewWatchdog(time.Minute, OnStall(func(err *StalledError, goroutines []byte) {
+		reported = append(reported, err)
+		dump = goroutines
+	}), WithClock(clk))
+
+	stuck := Watch(w, "stuck", newFuture[int]())
+	finished := Watch(w, "finished", Go(func() (int, error) { return 1, nil }))
+	finished.Await()
+
+	if n := w.Check(); n != 0 || w.Len() != 1 {
+		t.Fatalf("Expected no stalls and one pending future, got %d stalls, %d pending", n, w.Len())
+	}
+	clk.Advance(2 * time.Minute)
+	if n := w.Check(); n != 1 || w.Len() != 0 {
+		t.Fatalf("Expected one stall and no pending futures, got %d stalls, %d pending", n, w.Len())
+	}
+
+	var stalled *StalledError
+	if r := stuck.Await(); !r.HasErrAs(&stalled) || stalled.Name != "stuck" || stalled.Age != 2*time.Minute {
+		t.Errorf("Expected a StalledError for stuck, got %v", r)
+	}
+	if len(reported) != 1 || !strings.Contains(string(dump), "goroutine") {
+		t.Errorf("Expected the stall to be reported with a goroutine dump, got %v", reported)
+	}
+	if !errors.As(stuck.Await().GetErr(), &stalled) {
+		t.Error("Expected the StalledError to be retrievable with errors.As")
+	}
+}
+
+func TestWatchdog_Run(t *testing.T) {
+	clk := clocktest.NewFake(time.Unix(0, 0))
+	w := NewWatchdog(time.Minute, WithClock(clk), WithInterval(10*time.Second))
+	f := Watch(w, "stuck", newFuture[int]())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Run(ctx)
+	for range 7 {
+		clk.BlockUntil(1)
+		clk.Advance(10 * time.Second)
+	}
+	var stalled *StalledError
+	if r := f.Await(); !r.HasErrAs(&stalled) {
+		t.Errorf("Expected Run to fail the stalled future, got %v", r)
+	}
+}
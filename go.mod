@@ -1,3 +1,14 @@
 module github.com/viocha/go-option
 
 go 1.24.0
+
+require (
+	github.com/google/go-cmp v0.6.0
+	google.golang.org/grpc v1.72.0
+	google.golang.org/protobuf v1.36.5
+)
+
+require (
+	golang.org/x/sys v0.30.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
+)
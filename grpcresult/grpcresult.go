@@ -0,0 +1,83 @@
+package grpcresult
+
+import (
+	"context"
+	"io/fs"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/internal/errmap"
+	"github.com/viocha/go-option/result"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ========================== 状态码映射 =============================
+
+var registry = errmap.New(
+	// 默认的映射，在注册的映射之后检查
+	errmap.Entry[codes.Code]{Target: context.DeadlineExceeded, Code: codes.DeadlineExceeded},
+	errmap.Entry[codes.Code]{Target: context.Canceled, Code: codes.Canceled},
+	errmap.Entry[codes.Code]{Target: fs.ErrNotExist, Code: codes.NotFound},
+	errmap.Entry[codes.Code]{Target: fs.ErrPermission, Code: codes.PermissionDenied},
+)
+
+// 错误链中包含 target 时（errors.Is）使用 code
+func Register(target error, code codes.Code) {
+	registry.Register(target, code)
+}
+
+// 错误码（参见 result.WithCode）为 code 时使用 c，优先于其他映射
+func RegisterCode(code string, c codes.Code) {
+	registry.RegisterCode(code, c)
+}
+
+// 注册自定义的映射函数，后注册的函数先检查
+func RegisterFunc(f func(error) opt.Option[codes.Code]) {
+	registry.RegisterFunc(f)
+}
+
+// 返回 err 对应的 gRPC 状态码，依次检查：错误码、错误链中的 gRPC 状态、注册的映射、默认映射，都不匹配时为 Unknown
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	return registry.Lookup(err, func(err error) opt.Option[codes.Code] {
+		if s, ok := status.FromError(err); ok {
+			return opt.Val(s.Code())
+		}
+		return opt.Nul[codes.Code]()
+	}).GetOr(codes.Unknown)
+}
+
+// ========================== 转换 =============================
+
+// 将 gRPC 调用的返回值转换为 Result。Err 中保留原错误（status.FromError 仍然可用），
+// 并以状态码的名称（如 "NotFound"）作为错误码，可以使用 Result.HasCode 判断
+func FromGRPC[T any](resp T, err error) result.Result[T] {
+	if err == nil {
+		return result.Ok(resp)
+	}
+	return result.ErrWithCode[T](status.Code(err).String(), err)
+}
+
+// 将 Result 转换为 gRPC 状态：Ok 时为 OK，Err 时状态码由 Code 确定，消息为错误消息。
+// 错误链中已经包含 gRPC 状态且没有被映射覆盖时，沿用该状态（包括其中的 details）
+func ToStatus[T any](r result.Result[T]) *status.Status {
+	if r.IsOk() {
+		return status.New(codes.OK, "")
+	}
+	err := r.GetErr()
+	c := Code(err)
+	if s, ok := status.FromError(err); ok && s.Code() == c {
+		return s
+	}
+	return status.New(c, err.Error())
+}
+
+// 将 Result 转换为 gRPC 处理函数的返回值
+func ToGRPC[T any](r result.Result[T]) (T, error) {
+	if r.IsOk() {
+		return r.Get(), nil
+	}
+	return r.GetOrZero(), ToStatus(r).Err()
+}
@@ -0,0 +1,48 @@
+package grpcresult
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFromGRPC(t *testing.T) {
+	r := FromGRPC[*int](nil, status.Error(codes.NotFound, "no user"))
+	if !r.HasCode("NotFound") || status.Code(r.GetErr()) != codes.NotFound {
+		t.Errorf("Expected a NotFound Err, got %v", r)
+	}
+	if r := FromGRPC(1, nil); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+}
+
+func TestToStatus(t *testing.T) {
+	errQuota := errors.New("quota exceeded")
+	Register(errQuota, codes.ResourceExhausted)
+	RegisterCode("invalid_name", codes.InvalidArgument)
+
+	cases := []struct {
+		r    result.Result[int]
+		code codes.Code
+	}{
+		{result.Ok(1), codes.OK},
+		{result.Err[int](fmt.Errorf("wrap: %w", errQuota)), codes.ResourceExhausted},
+		{result.ErrWithCode[int]("invalid_name", errors.New("empty")), codes.InvalidArgument},
+		{result.Err[int](context.DeadlineExceeded), codes.DeadlineExceeded},
+		{result.Err[int](status.Error(codes.Aborted, "conflict")).Wrap("save"), codes.Aborted},
+		{result.Err[int](errors.New("boom")), codes.Unknown},
+	}
+	for _, c := range cases {
+		if got := ToStatus(c.r).Code(); got != c.code {
+			t.Errorf("ToStatus(%v) = %v, want %v", c.r, got, c.code)
+		}
+	}
+	if _, err := ToGRPC(result.Err[int](errQuota)); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected a status error, got %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package httpresult
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/internal/errmap"
+	"github.com/viocha/go-option/result"
+)
+
+// 客户端在响应前断开连接时使用的状态码（非标准，沿用 nginx 的约定）
+const StatusClientClosedRequest = 499
+
+// 实现该接口的错误直接决定响应的状态码
+type statusCoder interface {
+	HTTPStatus() int
+}
+
+// ========================== 状态码映射 =============================
+
+var registry = errmap.New(
+	// 默认的映射，在注册的映射之后检查
+	errmap.Entry[int]{Target: context.DeadlineExceeded, Code: http.StatusGatewayTimeout},
+	errmap.Entry[int]{Target: context.Canceled, Code: StatusClientClosedRequest},
+	errmap.Entry[int]{Target: fs.ErrNotExist, Code: http.StatusNotFound},
+	errmap.Entry[int]{Target: sql.ErrNoRows, Code: http.StatusNotFound},
+	errmap.Entry[int]{Target: fs.ErrPermission, Code: http.StatusForbidden},
+)
+
+// 错误链中包含 target 时（errors.Is）使用 status
+func Register(target error, status int) {
+	registry.Register(target, status)
+}
+
+// 错误码（参见 result.WithCode）为 code 时使用 status，优先于其他映射
+func RegisterCode(code string, status int) {
+	registry.RegisterCode(code, status)
+}
+
+// 注册自定义的映射函数，后注册的函数先检查
+func RegisterFunc(f func(error) opt.Option[int]) {
+	registry.RegisterFunc(f)
+}
+
+// 返回 err 对应的状态码，依次检查：错误码、HTTPStatus() int 方法、注册的映射、默认映射，都不匹配时为 500
+func Status(err error) int {
+	return registry.Lookup(err, func(err error) opt.Option[int] {
+		var sc statusCoder
+		if errors.As(err, &sc) {
+			return opt.Val(sc.HTTPStatus())
+		}
+		return opt.Nul[int]()
+	}).GetOr(http.StatusInternalServerError)
+}
+
+// ========================== 写入响应 =============================
+
+// Err 时的响应体
+type ErrorBody struct {
+	Error string `json:"error"`
+	Code  string `json:"code,omitempty"`
+}
+
+// 将 r 写入 w：Ok 时以 200 输出 JSON 编码的值；Err 时按 Status 确定状态码并输出 ErrorBody。
+// 5xx 错误的消息不会暴露给客户端，改为输出状态码的标准文本
+func Write[T any](w http.ResponseWriter, r result.Result[T]) {
+	if r.IsOk() {
+		writeJSON(w, http.StatusOK, r.Get())
+		return
+	}
+	err := r.GetErr()
+	status := Status(err)
+	body := ErrorBody{Error: err.Error(), Code: r.Code().GetOrZero()}
+	if status >= 500 {
+		body.Error = http.StatusText(status)
+	}
+	writeJSON(w, status, body)
+}
+
+// 将返回 Result 的函数转换为 http.HandlerFunc
+func Handler[T any](f func(*http.Request) result.Result[T]) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		Write(w, f(req))
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(append(data, '\n'))
+}
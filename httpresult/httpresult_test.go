@@ -0,0 +1,54 @@
+package httpresult
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestWrite(t *testing.T) {
+	errQuota := errors.New("quota exceeded")
+	Register(errQuota, http.StatusTooManyRequests)
+	RegisterCode("invalid_name", http.StatusBadRequest)
+
+	cases := []struct {
+		r      result.Result[map[string]int]
+		status int
+		body   string
+	}{
+		{result.Ok(map[string]int{"a": 1}), 200, `{"a":1}`},
+		{result.Err[map[string]int](fmt.Errorf("wrap: %w", errQuota)), 429, `{"error":"wrap: quota exceeded"}`},
+		{result.ErrWithCode[map[string]int]("invalid_name", errors.New("empty name")), 400, `{"error":"empty name","code":"invalid_name"}`},
+		{result.Err[map[string]int](context.DeadlineExceeded), 504, `{"error":"Gateway Timeout"}`},
+		{result.Err[map[string]int](errors.New("db password=x")), 500, `{"error":"Internal Server Error"}`},
+	}
+	for _, c := range cases {
+		rec := httptest.NewRecorder()
+		Write(rec, c.r)
+		if rec.Code != c.status || strings.TrimSpace(rec.Body.String()) != c.body {
+			t.Errorf("Write(%v) = %d %s, want %d %s", c.r, rec.Code, rec.Body, c.status, c.body)
+		}
+	}
+}
+
+type teapotError struct{}
+
+func (teapotError) Error() string   { return "teapot" }
+func (teapotError) HTTPStatus() int { return http.StatusTeapot }
+
+func TestHandler(t *testing.T) {
+	h := Handler(func(*http.Request) result.Result[int] {
+		return result.Err[int](teapotError{})
+	})
+	rec := httptest.NewRecorder()
+	h(rec, httptest.NewRequest("GET", "/", nil))
+	if rec.Code != http.StatusTeapot || rec.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("Unexpected response %d %v", rec.Code, rec.Header())
+	}
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"errors"
+	"runtime/debug"
+)
+
+var ErrGoexit = errors.New("function exited via runtime.Goexit") // f 调用了 runtime.Goexit，例如 t.FailNow
+
+// 执行 f，f 因 panic 或 runtime.Goexit 没有正常返回时以描述原因的错误调用 cleanup，之后 panic 继续向上传播。
+// 用于在任意 panic 下都需要释放资源或唤醒等待者的场景
+func OnAbort(f func(), cleanup func(err error)) {
+	normal := false
+	defer func() {
+		if normal {
+			return
+		}
+		v := recover()
+		if v == nil {
+			cleanup(ErrGoexit)
+			return
+		}
+		cleanup(NewPanicError(v, debug.Stack()))
+		panic(v)
+	}()
+	f()
+	normal = true
+}
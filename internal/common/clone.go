@@ -0,0 +1,9 @@
+package common
+
+// 值的类型实现了 Clone() T 时返回其结果，否则原样返回（浅拷贝）
+func Clone[T any](v T) T {
+	if c, ok := any(v).(interface{ Clone() T }); ok {
+		return c.Clone()
+	}
+	return v
+}
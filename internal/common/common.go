@@ -3,6 +3,7 @@ package common
 import (
 	"errors"
 	"fmt"
+	"runtime/debug"
 )
 
 // 如果传入的值是 error 类型，则直接返回该 error，否则将其包装为 error 类型
@@ -13,6 +14,38 @@ func ToError(r any) error {
 	return fmt.Errorf("%v", r)
 }
 
+// 由 panic 转换而来的错误，保存了 panic 的值和发生时的调用栈
+type PanicError struct {
+	value any
+	stack []byte
+}
+
+func NewPanicError(value any, stack []byte) *PanicError {
+	return &PanicError{value: value, stack: stack}
+}
+
+func (e *PanicError) Error() string {
+	return ToError(e.value).Error()
+}
+
+// 如果 panic 的值是 error，则返回该 error，以便 errors.Is/As 可以继续匹配
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// panic 的原始值
+func (e *PanicError) Value() any {
+	return e.value
+}
+
+// panic 发生时的调用栈
+func (e *PanicError) Stack() []byte {
+	return e.stack
+}
+
 // 可以从panic中安全地执行函数，返回是否成功执行。
 // errs指定需要捕获的错误类型列表，默认捕获所有错误
 func SafeDo(f func(), errs ...error) error {
@@ -20,14 +53,14 @@ func SafeDo(f func(), errs ...error) error {
 	deferFn := func() {
 		if r := recover(); r != nil {
 			if len(errs) == 0 { // 如果没有指定错误类型列表
-				err = ToError(r) // 直接转换为 error
+				err = NewPanicError(r, debug.Stack()) // 直接转换为 error
 				return
 			}
 			// 检查是否是指定的错误类型
 			if r, ok := r.(error); ok { // 如果 r 是 error 类型
 				for _, e := range errs {
 					if errors.Is(r, e) { // 如果 r 是指定的错误类型，则捕获并返回
-						err = r
+						err = NewPanicError(r, debug.Stack())
 						return
 					}
 				}
@@ -0,0 +1,87 @@
+package common
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// 将 v 无损地转换为 typ 类型：可以直接赋值时原样返回；数值之间的转换要求值在目标类型的范围内且不丢失精度；
+// 字符串与 []byte 之间互相转换（[]byte 会被复制）；种类相同的字符串或 bool 类型之间直接转换。其余情况返回错误，
+// 不会进行整数到字符串的 rune 转换、溢出回绕或浮点数截断
+func ConvertValue(v reflect.Value, typ reflect.Type) (reflect.Value, error) {
+	if !v.IsValid() {
+		return reflect.Value{}, fmt.Errorf("cannot convert nil to %v", typ)
+	}
+	if v.Type().AssignableTo(typ) {
+		return v, nil
+	}
+	from, to := v.Kind(), typ.Kind()
+	switch {
+	case isNumber(from) && isNumber(to):
+		out := v.Convert(typ)
+		if !losslessNumber(v, out) {
+			return reflect.Value{}, fmt.Errorf("cannot convert %v (%v) to %v without loss", v, v.Type(), typ)
+		}
+		return out, nil
+	case from == to && (from == reflect.String || from == reflect.Bool):
+		return v.Convert(typ), nil
+	case from == reflect.String && isBytes(typ):
+		return reflect.ValueOf([]byte(v.String())).Convert(typ), nil
+	case isBytes(v.Type()) && to == reflect.String:
+		return reflect.ValueOf(string(v.Bytes())).Convert(typ), nil
+	case isBytes(v.Type()) && isBytes(typ):
+		return reflect.ValueOf(append([]byte(nil), v.Bytes()...)).Convert(typ), nil
+	}
+	return reflect.Value{}, fmt.Errorf("cannot convert %v to %v", v.Type(), typ)
+}
+
+func isNumber(k reflect.Kind) bool {
+	return isInt(k) || isUint(k) || k == reflect.Float32 || k == reflect.Float64
+}
+
+func isInt(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
+
+func isUint(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uintptr
+}
+
+func isBytes(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
+
+// out 是否与 in 表示同一个数值
+func losslessNumber(in, out reflect.Value) bool {
+	switch k := in.Kind(); {
+	case isInt(k):
+		i := in.Int()
+		switch {
+		case isInt(out.Kind()):
+			return out.Int() == i
+		case isUint(out.Kind()):
+			return i >= 0 && out.Uint() == uint64(i)
+		}
+		f := out.Float()
+		return f >= -1<<63 && f < 1<<63 && int64(f) == i
+	case isUint(k):
+		u := in.Uint()
+		switch {
+		case isInt(out.Kind()):
+			return out.Int() >= 0 && uint64(out.Int()) == u
+		case isUint(out.Kind()):
+			return out.Uint() == u
+		}
+		f := out.Float()
+		return f < 1<<64 && uint64(f) == u
+	}
+	f := in.Float()
+	switch {
+	case isInt(out.Kind()):
+		return f >= -1<<63 && f < 1<<63 && float64(out.Int()) == f
+	case isUint(out.Kind()):
+		return f >= 0 && f < 1<<64 && float64(out.Uint()) == f
+	}
+	return out.Float() == f || math.IsNaN(f) && math.IsNaN(out.Float())
+}
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// 以 "-want +got" 的形式描述两个值的差异；两者为同类型的结构体时只列出不同的字段
+func Diff(want, got any) string {
+	var sb strings.Builder
+	sb.WriteString("(-want +got):\n")
+	wv, gv := reflect.ValueOf(want), reflect.ValueOf(got)
+	if wv.IsValid() && gv.IsValid() && wv.Type() == gv.Type() && wv.Kind() == reflect.Struct {
+		for i := range wv.NumField() {
+			f := wv.Type().Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			w, g := wv.Field(i).Interface(), gv.Field(i).Interface()
+			if !reflect.DeepEqual(w, g) {
+				fmt.Fprintf(&sb, "  %s:\n  \t- %#v\n  \t+ %#v\n", f.Name, w, g)
+			}
+		}
+		if sb.Len() > len("(-want +got):\n") {
+			return sb.String()
+		}
+	}
+	fmt.Fprintf(&sb, "  - %#v\n  + %#v\n", want, got)
+	return sb.String()
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"reflect"
+	"sync"
+)
+
+// 记录类型是否可以直接使用 == 比较，且结果与 reflect.DeepEqual 一致
+var flatTypes sync.Map // reflect.Type -> bool
+
+// 类型只由布尔、数值、字符串以及由它们组成的数组和结构体构成时，== 与 reflect.DeepEqual 等价
+func isFlat(t reflect.Type) bool {
+	if v, ok := flatTypes.Load(t); ok {
+		return v.(bool)
+	}
+	var flat bool
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		flat = true
+	case reflect.Array:
+		flat = isFlat(t.Elem())
+	case reflect.Struct:
+		flat = true
+		for i := range t.NumField() {
+			if !isFlat(t.Field(i).Type) {
+				flat = false
+				break
+			}
+		}
+	}
+	flatTypes.Store(t, flat)
+	return flat
+}
+
+// 比较两个值是否相等，语义与 reflect.DeepEqual 一致，可以使用 == 时走快速路径
+func Equal[T any](a, b T) bool {
+	if isFlat(reflect.TypeFor[T]()) {
+		return any(a) == any(b)
+	}
+	return reflect.DeepEqual(a, b)
+}
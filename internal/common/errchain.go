@@ -0,0 +1,24 @@
+package common
+
+// 深度优先遍历错误链（包括 Unwrap() error 与 Unwrap() []error 形成的树），对每个错误调用 f，depth 从 0 开始
+func WalkErr(err error, f func(err error, depth int)) {
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		f(err, depth)
+		switch e := err.(type) {
+		case interface{ Unwrap() error }:
+			if inner := e.Unwrap(); inner != nil {
+				walk(inner, depth+1)
+			}
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				if inner != nil {
+					walk(inner, depth+1)
+				}
+			}
+		}
+	}
+	if err != nil {
+		walk(err, 0)
+	}
+}
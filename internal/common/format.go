@@ -0,0 +1,38 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var typeNames sync.Map // reflect.Type -> string
+
+// 返回 T 的类型名，结果会被缓存。T 为接口类型且 v 不为 nil 时返回 v 的动态类型名
+func TypeName[T any](v T) string {
+	t := reflect.TypeFor[T]()
+	if t.Kind() == reflect.Interface {
+		if rv := reflect.ValueOf(v); rv.IsValid() {
+			t = rv.Type()
+		}
+	}
+	if name, ok := typeNames.Load(t); ok {
+		return name.(string)
+	}
+	name := t.String()
+	typeNames.Store(t, name)
+	return name
+}
+
+// 使用 f 中的格式化动词及标志格式化 v
+func FormatValue(f fmt.State, verb rune, v any) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), v)
+}
+
+// 按 %+v 的格式输出错误链，每个被包装的错误占一行
+func FormatErrChain(f fmt.State, err error) {
+	WalkErr(err, func(err error, depth int) {
+		fmt.Fprintf(f, "\n%s-> %T: %v", strings.Repeat("  ", depth+1), err, err)
+	})
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// 将字符串解析为 typ 类型的值：优先使用 encoding.TextUnmarshaler，其次是 time.Duration 和基础类型
+func ParseString(s string, typ reflect.Type) (reflect.Value, error) {
+	ptr := reflect.New(typ)
+	if u, ok := ptr.Interface().(encoding.TextUnmarshaler); ok {
+		return ptr.Elem(), u.UnmarshalText([]byte(s))
+	}
+	v := ptr.Elem()
+	if typ == durationType {
+		d, err := time.ParseDuration(s)
+		v.SetInt(int64(d))
+		return v, err
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return v, err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetFloat(f)
+	default:
+		return v, fmt.Errorf("no parser registered for %v", typ)
+	}
+	return v, nil
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"reflect"
+	"sync"
+)
+
+// 日志中代替敏感值输出的文本
+const RedactedText = "[REDACTED]"
+
+var redacted sync.Map // reflect.Type -> struct{}
+
+// 将类型 t 标记为敏感类型
+func Redact(t reflect.Type) {
+	redacted.Store(t, struct{}{})
+}
+
+// 判断 T 或 v 的动态类型是否被标记为敏感类型
+func IsRedacted[T any](v T) bool {
+	if _, ok := redacted.Load(reflect.TypeFor[T]()); ok {
+		return true
+	}
+	if t := reflect.TypeOf(v); t != nil {
+		_, ok := redacted.Load(t)
+		return ok
+	}
+	return false
+}
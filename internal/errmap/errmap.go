@@ -0,0 +1,77 @@
+package errmap
+
+import (
+	"errors"
+	"sync"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+// 默认映射中的一项：错误链中包含 Target 时（errors.Is）使用 Code
+type Entry[C any] struct {
+	Target error
+	Code   C
+}
+
+// 错误到状态码的映射表，由 httpresult 和 grpcresult 共用，可以安全地并发访问
+type Registry[C any] struct {
+	mu       sync.RWMutex
+	byCode   map[string]C
+	mappers  []func(error) opt.Option[C]
+	defaults []Entry[C]
+}
+
+// 创建映射表，defaults 在注册的映射之后检查
+func New[C any](defaults ...Entry[C]) *Registry[C] {
+	return &Registry[C]{byCode: make(map[string]C), defaults: defaults}
+}
+
+// 错误链中包含 target 时（errors.Is）使用 c
+func (r *Registry[C]) Register(target error, c C) {
+	r.RegisterFunc(func(err error) opt.Option[C] {
+		if errors.Is(err, target) {
+			return opt.Val(c)
+		}
+		return opt.Nul[C]()
+	})
+}
+
+// 错误码（参见 result.WithCode）为 code 时使用 c，优先于其他映射
+func (r *Registry[C]) RegisterCode(code string, c C) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byCode[code] = c
+}
+
+// 注册自定义的映射函数，后注册的函数先检查
+func (r *Registry[C]) RegisterFunc(f func(error) opt.Option[C]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mappers = append(r.mappers, f)
+}
+
+// 返回 err 对应的状态码，依次检查：错误码、direct（错误自身携带的状态码）、注册的映射、默认映射，都不匹配时为 Nul
+func (r *Registry[C]) Lookup(err error, direct func(error) opt.Option[C]) opt.Option[C] {
+	r.mu.RLock()
+	c, coded := r.byCode[result.Propagate[struct{}](err).Code().GetOrZero()]
+	fns := r.mappers
+	r.mu.RUnlock()
+	if coded {
+		return opt.Val(c)
+	}
+	if c := direct(err); c.IsVal() {
+		return c
+	}
+	for i := len(fns) - 1; i >= 0; i-- {
+		if c := fns[i](err); c.IsVal() {
+			return c
+		}
+	}
+	for _, d := range r.defaults {
+		if errors.Is(err, d.Target) {
+			return opt.Val(d.Code)
+		}
+	}
+	return opt.Nul[C]()
+}
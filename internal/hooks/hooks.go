@@ -0,0 +1,44 @@
+package hooks
+
+import (
+	"reflect"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// 传给观测钩子的事件
+type Event struct {
+	Err   error
+	Stack []byte       // 调用栈：panic 时为 panic 发生处，构造 Err 时为构造处
+	Type  reflect.Type // panic 时为 panic 值的类型，构造 Err 时为 Result 的值类型
+}
+
+var Panic, Err atomic.Pointer[func(Event)]
+
+// 交换钩子，返回之前的钩子
+func Swap(p *atomic.Pointer[func(Event)], f func(Event)) func(Event) {
+	var old *func(Event)
+	if f == nil {
+		old = p.Swap(nil)
+	} else {
+		old = p.Swap(&f)
+	}
+	if old == nil {
+		return nil
+	}
+	return *old
+}
+
+// panic 被转换为 Err/Nul 时调用
+func FirePanic(err error, value any, stack []byte) {
+	if f := Panic.Load(); f != nil {
+		(*f)(Event{Err: err, Stack: stack, Type: reflect.TypeOf(value)})
+	}
+}
+
+// 构造 Err 时调用，只有设置了钩子时才采集调用栈
+func FireErr[T any](err error) {
+	if f := Err.Load(); f != nil {
+		(*f)(Event{Err: err, Stack: debug.Stack(), Type: reflect.TypeFor[T]()})
+	}
+}
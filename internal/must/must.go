@@ -2,10 +2,31 @@ package must
 
 import (
 	"github.com/viocha/go-option/internal/common"
+	"github.com/viocha/go-option/internal/hooks"
+	"github.com/viocha/go-option/internal/stats"
 	"github.com/viocha/go-option/util"
 )
 
-// 捕获 ErrMust 错误的panic
+// 捕获 ErrMust 错误的panic，策略为 util.PropagatePanics 时不捕获任何 panic
 func CatchMustPanic(f func()) error {
-	return common.SafeDo(f, util.ErrMust)
+	if util.GetPanicPolicy() == util.PropagatePanics {
+		f()
+		return nil
+	}
+	err := common.SafeDo(f, util.ErrMust)
+	if pe, ok := err.(*common.PanicError); ok {
+		stats.Inc(&stats.CapturedPanics)
+		hooks.FirePanic(pe, pe.Value(), pe.Stack())
+	}
+	return err
+}
+
+// 捕获 f 中的任意 panic 并转换为 *common.PanicError，不受 util.SetPanicPolicy 影响
+func CatchAnyPanic(f func()) error {
+	err := common.SafeDo(f)
+	if pe, ok := err.(*common.PanicError); ok {
+		stats.Inc(&stats.CapturedPanics)
+		hooks.FirePanic(pe, pe.Value(), pe.Stack())
+	}
+	return err
 }
@@ -0,0 +1,112 @@
+package quickval
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+)
+
+// 与 testing/quick.Generator 相同的接口。
+// 不直接依赖 testing/quick，避免在使用方的程序中注册 -quickchecks 命令行参数
+type Generator interface {
+	Generate(rand *rand.Rand, size int) reflect.Value
+}
+
+var generatorType = reflect.TypeFor[Generator]()
+
+// 生成 t 类型的随机值，语义与 testing/quick.Value 一致，但会跳过结构体的未导出字段。
+// 无法生成时（如接口、通道、函数类型）返回 false
+func Value(t reflect.Type, rand *rand.Rand, size int) (reflect.Value, bool) {
+	if t.Implements(generatorType) {
+		return reflect.Zero(t).Interface().(Generator).Generate(rand, size), true
+	}
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Bool:
+		v.SetBool(rand.Int()&1 == 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(randInt64(rand) >> (64 - t.Bits()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(uint64(randInt64(rand)) >> (64 - t.Bits()))
+	case reflect.Float32:
+		v.SetFloat(randFloat(rand, math.MaxFloat32))
+	case reflect.Float64:
+		v.SetFloat(randFloat(rand, math.MaxFloat64))
+	case reflect.Complex64:
+		v.SetComplex(complex(randFloat(rand, math.MaxFloat32), randFloat(rand, math.MaxFloat32)))
+	case reflect.Complex128:
+		v.SetComplex(complex(randFloat(rand, math.MaxFloat64), randFloat(rand, math.MaxFloat64)))
+	case reflect.String:
+		runes := make([]rune, rand.Intn(size))
+		for i := range runes {
+			runes[i] = rune(rand.Intn(0x10ffff))
+		}
+		v.SetString(string(runes))
+	case reflect.Pointer:
+		if rand.Intn(size) == 0 {
+			break
+		}
+		elem, ok := Value(t.Elem(), rand, size)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		p := reflect.New(t.Elem())
+		p.Elem().Set(elem)
+		v.Set(p)
+	case reflect.Slice:
+		n := rand.Intn(size)
+		v.Set(reflect.MakeSlice(t, n, n))
+		for i := range n {
+			elem, ok := Value(t.Elem(), rand, size)
+			if !ok {
+				return reflect.Value{}, false
+			}
+			v.Index(i).Set(elem)
+		}
+	case reflect.Array:
+		for i := range v.Len() {
+			elem, ok := Value(t.Elem(), rand, size)
+			if !ok {
+				return reflect.Value{}, false
+			}
+			v.Index(i).Set(elem)
+		}
+	case reflect.Map:
+		n := rand.Intn(size)
+		v.Set(reflect.MakeMapWithSize(t, n))
+		for range n {
+			key, ok1 := Value(t.Key(), rand, size)
+			val, ok2 := Value(t.Elem(), rand, size)
+			if !ok1 || !ok2 {
+				return reflect.Value{}, false
+			}
+			v.SetMapIndex(key, val)
+		}
+	case reflect.Struct:
+		for i := range t.NumField() {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			elem, ok := Value(t.Field(i).Type, rand, size)
+			if !ok {
+				return reflect.Value{}, false
+			}
+			v.Field(i).Set(elem)
+		}
+	default:
+		return reflect.Value{}, false
+	}
+	return v, true
+}
+
+func randInt64(rand *rand.Rand) int64 {
+	return int64(rand.Uint64())
+}
+
+func randFloat(rand *rand.Rand, limit float64) float64 {
+	f := rand.Float64() * limit
+	if rand.Int()&1 == 0 {
+		f = -f
+	}
+	return f
+}
@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// 每构造多少个 Ok/Err 采样一次调用处，0 表示关闭
+var SiteEvery atomic.Int64
+
+var (
+	siteTick atomic.Int64
+	Sites    sync.Map // SiteKey -> *SiteCounts
+)
+
+type SiteKey struct {
+	File string
+	Line int
+}
+
+// 一个调用处的采样计数
+type SiteCounts struct {
+	Func string
+	Oks  atomic.Uint64
+	Errs atomic.Uint64
+}
+
+const modulePrefix = "github.com/viocha/go-option/"
+
+// 按采样率记录构造 Ok/Err 的调用处，调用处为调用栈中第一个不属于本模块（测试文件除外）的函数。
+// 关闭时只有一次原子读取的开销
+func Site(isErr bool) {
+	every := SiteEvery.Load()
+	if every <= 0 || siteTick.Add(1)%every != 0 {
+		return
+	}
+	var pcs [16]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs[:])])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, modulePrefix) || strings.HasSuffix(frame.File, "_test.go") || !more {
+			record(frame, isErr)
+			return
+		}
+	}
+}
+
+func record(frame runtime.Frame, isErr bool) {
+	key := SiteKey{File: frame.File, Line: frame.Line}
+	c, ok := Sites.Load(key)
+	if !ok {
+		c, _ = Sites.LoadOrStore(key, &SiteCounts{Func: frame.Function})
+	}
+	if isErr {
+		c.(*SiteCounts).Errs.Add(1)
+	} else {
+		c.(*SiteCounts).Oks.Add(1)
+	}
+}
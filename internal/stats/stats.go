@@ -0,0 +1,22 @@
+package stats
+
+import "sync/atomic"
+
+// 是否开启计数，关闭时每次调用只有一次原子读取的开销
+var Enabled atomic.Bool
+
+var (
+	Somes          atomic.Uint64
+	Nones          atomic.Uint64
+	Oks            atomic.Uint64
+	Errs           atomic.Uint64
+	CapturedPanics atomic.Uint64
+	FailedUnwraps  atomic.Uint64
+)
+
+// 开启计数时将 c 加一
+func Inc(c *atomic.Uint64) {
+	if Enabled.Load() {
+		c.Add(1)
+	}
+}
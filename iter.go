@@ -0,0 +1,57 @@
+package option
+
+import "iter"
+
+// ========================== 迭代器 =============================
+
+// 返回一个迭代器，存在值时产出该值，否则不产出任何元素
+func (o Option[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.IsVal() {
+			yield(o.Get())
+		}
+	}
+}
+
+// 取迭代器的第一个元素，迭代器为空时返回 Nul
+func FromSeq[T any](seq iter.Seq[T]) Option[T] {
+	for v := range seq {
+		return Val(v)
+	}
+	return Nul[T]()
+}
+
+// 对序列中每个 Option 执行 Map
+func MapSeq[T, U any](seq iter.Seq[Option[T]], f func(T) U) iter.Seq[Option[U]] {
+	return func(yield func(Option[U]) bool) {
+		for o := range seq {
+			if !yield(Map(o, f)) {
+				return
+			}
+		}
+	}
+}
+
+// 对序列中每个存在的值执行f，只产出f返回的存在的值
+func FilterMapSeq[T, U any](seq iter.Seq[Option[T]], f func(T) Option[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for o := range seq {
+			u := Then(o, f)
+			if u.IsVal() && !yield(u.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// 将序列收集为 Option[[]T]，遇到不存在值的元素时停止并返回 Nul
+func CollectSeq[T any](seq iter.Seq[Option[T]]) Option[[]T] {
+	var vals []T
+	for o := range seq {
+		if o.IsNul() {
+			return Nul[[]T]()
+		}
+		vals = append(vals, o.Get())
+	}
+	return Val(vals)
+}
@@ -0,0 +1,50 @@
+package option
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOption_Iter(t *testing.T) {
+	if vals := slices.Collect(Val(1).Iter()); !slices.Equal(vals, []int{1}) {
+		t.Errorf("Expected [1], got %v", vals)
+	}
+	if vals := slices.Collect(Nul[int]().Iter()); len(vals) != 0 {
+		t.Errorf("Expected [], got %v", vals)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	if o := FromSeq(slices.Values([]int{3, 4})); !o.Has(3) {
+		t.Errorf("Expected Some(3), got %v", o)
+	}
+	if o := FromSeq(slices.Values([]int{})); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
+
+func TestSeqAdapters(t *testing.T) {
+	opts := slices.Values([]Option[int]{Val(1), Nul[int](), Val(3)})
+
+	mapped := slices.Collect(MapSeq(opts, func(v int) int { return v * 10 }))
+	if len(mapped) != 3 || !mapped[0].Has(10) || mapped[1].IsVal() || !mapped[2].Has(30) {
+		t.Errorf("Unexpected MapSeq output: %v", mapped)
+	}
+
+	odd := func(v int) Option[int] {
+		if v%2 == 1 {
+			return Val(v)
+		}
+		return Nul[int]()
+	}
+	if vals := slices.Collect(FilterMapSeq(opts, odd)); !slices.Equal(vals, []int{1, 3}) {
+		t.Errorf("Expected [1 3], got %v", vals)
+	}
+
+	if o := CollectSeq(opts); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if o := CollectSeq(slices.Values([]Option[int]{Val(1), Val(2)})); !o.IsVal() || !slices.Equal(o.Get(), []int{1, 2}) {
+		t.Errorf("Expected Some([1 2]), got %v", o)
+	}
+}
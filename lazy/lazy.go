@@ -0,0 +1,57 @@
+package lazy
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/result"
+)
+
+// 延迟计算的值：第一次 Get 时计算并缓存结果（包括错误），可安全地并发使用
+type Value[T any] struct {
+	once sync.Once
+	f    func() result.Result[T]
+	res  result.Result[T]
+	done atomic.Bool
+}
+
+// 使用 f 创建延迟值
+func New[T any](f func() (T, error)) *Value[T] {
+	return FromResult(func() result.Result[T] { return result.From(f()) })
+}
+
+// 使用返回 Result 的 f 创建延迟值
+func FromResult[T any](f func() result.Result[T]) *Value[T] {
+	return &Value[T]{f: f}
+}
+
+// 返回计算结果，第一次调用时执行计算，f 中的 ErrMust panic 会被转换为 Err
+func (v *Value[T]) Get() result.Result[T] {
+	v.once.Do(func() {
+		if err := must.CatchMustPanic(func() {
+			v.res = v.f()
+		}); err != nil {
+			v.res = result.Err[T](err)
+		}
+		v.f = nil
+		v.done.Store(true)
+	})
+	return v.res
+}
+
+// 已计算时返回结果，否则返回 Nul，不会触发计算
+func (v *Value[T]) Peek() opt.Option[result.Result[T]] {
+	if !v.done.Load() {
+		return opt.Nul[result.Result[T]]()
+	}
+	return opt.Val(v.res)
+}
+
+// 实现 warmup.Warmable，执行计算并返回其中的错误
+func (v *Value[T]) Warm(context.Context) error {
+	_, err := v.Get().Unpack()
+	return err
+}
@@ -0,0 +1,60 @@
+package lazy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+	"github.com/viocha/go-option/util"
+)
+
+func TestValue(t *testing.T) {
+	var calls atomic.Int32
+	v := New(func() (string, error) {
+		calls.Add(1)
+		return "config", nil
+	})
+	if v.Peek().IsVal() {
+		t.Error("Expected Peek to be None before Get")
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if r := v.Get(); !r.Has("config") {
+				t.Errorf("Expected Ok(config), got %v", r)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("Expected one computation, got %d", calls.Load())
+	}
+	if p := v.Peek(); p.IsNul() || !p.Get().Has("config") {
+		t.Errorf("Expected Peek to return the cached result, got %v", p)
+	}
+}
+
+func TestValue_Err(t *testing.T) {
+	errLoad := errors.New("load failed")
+	v := New(func() (int, error) { return 0, errLoad })
+	if err := v.Warm(context.Background()); !errors.Is(err, errLoad) {
+		t.Errorf("Expected Warm to return the error, got %v", err)
+	}
+	if r := v.Get(); !r.HasErr(errLoad) {
+		t.Errorf("Expected the error to be cached, got %v", r)
+	}
+
+	p := FromResult(func() result.Result[int] {
+		util.MustNil(errLoad)
+		return result.Ok(1)
+	})
+	if r := p.Get(); !errors.Is(r.GetErr(), errLoad) {
+		t.Errorf("Expected the must panic to become Err, got %v", r)
+	}
+}
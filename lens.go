@@ -0,0 +1,59 @@
+package option
+
+// ========================== Lens =============================
+
+// 读取和不可变地更新 S 中可选字段 T 的访问器
+type Lens[S, T any] struct {
+	get func(S) Option[T]
+	set func(S, T) S
+}
+
+// get 读取字段，set 返回更新字段后的新 S
+func MakeLens[S, T any](get func(S) Option[T], set func(S, T) S) Lens[S, T] {
+	return Lens[S, T]{get: get, set: set}
+}
+
+func (l Lens[S, T]) Get(s S) Option[T] {
+	return l.get(s)
+}
+
+func (l Lens[S, T]) Set(s S, v T) S {
+	return l.set(s, v)
+}
+
+// 如果字段存在值，则使用f更新该值，否则原样返回 s
+func (l Lens[S, T]) Modify(s S, f func(T) T) S {
+	o := l.get(s)
+	if o.IsNul() {
+		return s
+	}
+	return l.set(s, f(o.Get()))
+}
+
+// 组合两个 Lens，用于访问嵌套字段。中间字段不存在值时，Set 原样返回 s
+func Compose[A, B, C any](outer Lens[A, B], inner Lens[B, C]) Lens[A, C] {
+	return Lens[A, C]{
+		get: func(a A) Option[C] {
+			return Then(outer.get(a), inner.get)
+		},
+		set: func(a A, c C) A {
+			b := outer.get(a)
+			if b.IsNul() {
+				return a
+			}
+			return outer.set(a, inner.set(b.Get(), c))
+		},
+	}
+}
+
+// ========================== 字段访问 =============================
+
+// 读取存在的 S 中的字段，与 Map 相同，用于在管道中逐层访问嵌套字段
+func Field[S, T any](o Option[S], get func(S) T) Option[T] {
+	return Map(o, get)
+}
+
+// 读取存在的 S 中的指针字段，指针为 nil 时返回 Nul
+func FieldPtr[S, T any](o Option[S], get func(S) *T) Option[T] {
+	return Then(o, func(s S) Option[T] { return FromPtr(get(s)) })
+}
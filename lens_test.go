@@ -0,0 +1,80 @@
+package option
+
+import "testing"
+
+type lensAddress struct {
+	City *string
+}
+
+type lensUser struct {
+	Address *lensAddress
+}
+
+var (
+	userAddress = MakeLens(
+		func(u lensUser) Option[lensAddress] { return FromPtr(u.Address) },
+		func(u lensUser, a lensAddress) lensUser { u.Address = &a; return u },
+	)
+	addressCity = MakeLens(
+		func(a lensAddress) Option[string] { return FromPtr(a.City) },
+		func(a lensAddress, c string) lensAddress { a.City = &c; return a },
+	)
+	userCity = Compose(userAddress, addressCity)
+)
+
+func TestLens(t *testing.T) {
+	city := "Paris"
+	u := lensUser{Address: &lensAddress{City: &city}}
+	if c := userCity.Get(u); !c.Has("Paris") {
+		t.Errorf("Expected Some(Paris), got %v", c)
+	}
+
+	u2 := userCity.Set(u, "Lyon")
+	if c := userCity.Get(u2); !c.Has("Lyon") {
+		t.Errorf("Expected Some(Lyon), got %v", c)
+	}
+	if *u.Address.City != "Paris" {
+		t.Error("Expected Set not to mutate the original value")
+	}
+
+	u3 := userCity.Modify(u, func(c string) string { return c + "!" })
+	if c := userCity.Get(u3); !c.Has("Paris!") {
+		t.Errorf("Expected Some(Paris!), got %v", c)
+	}
+}
+
+func TestLens_Missing(t *testing.T) {
+	u := lensUser{}
+	if c := userCity.Get(u); c.IsVal() {
+		t.Errorf("Expected None for missing address, got %v", c)
+	}
+	if u2 := userCity.Set(u, "Lyon"); u2.Address != nil {
+		t.Error("Expected Set to leave the value unchanged when the outer field is missing")
+	}
+
+	u = lensUser{Address: &lensAddress{}}
+	if u2 := userCity.Set(u, "Lyon"); !userCity.Get(u2).Has("Lyon") {
+		t.Error("Expected Set to fill a missing leaf field")
+	}
+}
+
+func TestFieldPtr(t *testing.T) {
+	type city struct{ Name string }
+	type address struct{ City *city }
+	type user struct{ Address *address }
+
+	name := func(u *user) Option[string] {
+		addr := FieldPtr(FromPtr(u), func(u user) *address { return u.Address })
+		c := FieldPtr(addr, func(a address) *city { return a.City })
+		return Field(c, func(c city) string { return c.Name })
+	}
+	if got := name(&user{Address: &address{City: &city{Name: "Paris"}}}); !got.Has("Paris") {
+		t.Errorf("Expected Some(Paris), got %v", got)
+	}
+	if got := name(&user{Address: &address{}}); got.IsVal() {
+		t.Errorf("Expected None for a nil field, got %v", got)
+	}
+	if got := name(nil); got.IsVal() {
+		t.Errorf("Expected None for a nil root, got %v", got)
+	}
+}
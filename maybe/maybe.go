@@ -0,0 +1,110 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	opt "github.com/viocha/go-option"
+)
+
+type state int
+
+const (
+	undefined state = iota
+	null
+	defined
+)
+
+// 区分"未提供"、"显式为 null"和"存在值"三种状态的可选值，零值为 Undefined。
+// 作为 JSON 结构体字段时，缺失的键解码为 Undefined，null 解码为 Null；配合 omitzero 编码时 Undefined 会被省略
+type Maybe[T any] struct {
+	state state
+	val   T
+}
+
+// ========================== 构造器 =============================
+
+func Undefined[T any]() Maybe[T] {
+	return Maybe[T]{state: undefined}
+}
+
+func Null[T any]() Maybe[T] {
+	return Maybe[T]{state: null}
+}
+
+func Val[T any](v T) Maybe[T] {
+	return Maybe[T]{state: defined, val: v}
+}
+
+// Val(v) 转换为 Val(v)，Nul 转换为 Null
+func FromOption[T any](o opt.Option[T]) Maybe[T] {
+	if o.IsNul() {
+		return Null[T]()
+	}
+	return Val(o.Get())
+}
+
+// ========================== 方法 =============================
+
+func (m Maybe[T]) IsUndefined() bool { return m.state == undefined }
+func (m Maybe[T]) IsNull() bool      { return m.state == null }
+func (m Maybe[T]) IsVal() bool       { return m.state == defined }
+
+// 是否提供了该值（Null 或 Val）
+func (m Maybe[T]) IsDefined() bool { return m.state != undefined }
+
+// 如果存在值，则返回该值。否则 panic
+func (m Maybe[T]) Get() T {
+	if !m.IsVal() {
+		panic(fmt.Sprintf("called Maybe.Get() on a %v value", m))
+	}
+	return m.val
+}
+
+// Val(v) 转换为 Val(v)，Undefined 和 Null 都转换为 Nul
+func (m Maybe[T]) Option() opt.Option[T] {
+	return opt.FromOk(m.val, m.IsVal())
+}
+
+// 提供了该值时返回 Val(其 Option 形式)，Undefined 时返回 Nul，便于区分"不修改"与"清空"
+func (m Maybe[T]) Defined() opt.Option[opt.Option[T]] {
+	return opt.FromOk(m.Option(), m.IsDefined())
+}
+
+func (m Maybe[T]) String() string {
+	switch m.state {
+	case null:
+		return "Null"
+	case defined:
+		return fmt.Sprintf("Val(%v)", m.val)
+	}
+	return "Undefined"
+}
+
+// Undefined 时为 true，encoding/json 的 omitzero 据此省略未提供的字段
+func (m Maybe[T]) IsZero() bool {
+	return m.IsUndefined()
+}
+
+// 实现 json.Marshaler：Val(v) 编码为 v，Null 和 Undefined 编码为 null
+func (m Maybe[T]) MarshalJSON() ([]byte, error) {
+	if !m.IsVal() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(m.val)
+}
+
+// 实现 json.Unmarshaler：null 解码为 Null，其余解码为 Val。缺失的键不会调用该方法，因此保持为 Undefined
+func (m *Maybe[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*m = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = Val(v)
+	return nil
+}
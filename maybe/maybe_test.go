@@ -0,0 +1,77 @@
+package maybe
+
+import (
+	"encoding/json"
+	"testing"
+
+	opt "github.com/viocha/go-option"
+)
+
+type update struct {
+	Name Maybe[string] `json:"name,omitzero"`
+	Age  Maybe[int]    `json:"age,omitzero"`
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var u update
+	if err := json.Unmarshal([]byte(`{"name": null, "age": 3}`), &u); err != nil {
+		t.Fatal(err)
+	}
+	if !u.Name.IsNull() || !u.Age.IsVal() || u.Age.Get() != 3 {
+		t.Errorf("Unexpected decoded value: %v, %v", u.Name, u.Age)
+	}
+
+	u = update{}
+	if err := json.Unmarshal([]byte(`{}`), &u); err != nil {
+		t.Fatal(err)
+	}
+	if !u.Name.IsUndefined() || !u.Age.IsUndefined() {
+		t.Errorf("Expected missing keys to be Undefined, got %v, %v", u.Name, u.Age)
+	}
+
+	if err := json.Unmarshal([]byte(`{"age": "x"}`), &u); err == nil {
+		t.Error("Expected error for invalid value")
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   update
+		want string
+	}{
+		{update{}, `{}`},
+		{update{Name: Null[string]()}, `{"name":null}`},
+		{update{Name: Val("a"), Age: Val(1)}, `{"name":"a","age":1}`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.in)
+		if err != nil || string(data) != tt.want {
+			t.Errorf("Expected %s, got %s (%v)", tt.want, data, err)
+		}
+	}
+}
+
+func TestOption(t *testing.T) {
+	if o := Val(1).Option(); !o.Has(1) {
+		t.Errorf("Expected Val(1), got %v", o)
+	}
+	if Null[int]().Option().IsVal() || Undefined[int]().Option().IsVal() {
+		t.Error("Expected Null and Undefined to convert to Nul")
+	}
+	if d := Null[int]().Defined(); !d.IsVal() || d.Get().IsVal() {
+		t.Errorf("Expected Val(Nul), got %v", d)
+	}
+	if d := Undefined[int]().Defined(); d.IsVal() {
+		t.Errorf("Expected Nul, got %v", d)
+	}
+	if m := FromOption(opt.Nul[int]()); !m.IsNull() {
+		t.Errorf("Expected Null, got %v", m)
+	}
+	if m := FromOption(opt.Val(2)); !m.IsVal() || m.Get() != 2 {
+		t.Errorf("Expected Val(2), got %v", m)
+	}
+	var zero Maybe[int]
+	if !zero.IsUndefined() || zero.String() != "Undefined" {
+		t.Errorf("Expected the zero Maybe to be Undefined, got %v", zero)
+	}
+}
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"cmp"
+	"expvar"
+	"slices"
+	"sync/atomic"
+
+	"github.com/viocha/go-option/internal/stats"
+)
+
+// 计数器的快照
+type Snapshot struct {
+	Somes          uint64 // 构造的 Val
+	Nones          uint64 // 构造的 Nul
+	Oks            uint64 // 构造的 Ok
+	Errs           uint64 // 构造的 Err，链式方法转发上游的错误（result.Propagate）不计入
+	CapturedPanics uint64 // 链式方法捕获并转换为 Nul/Err 的 panic
+	FailedUnwraps  uint64 // 对 Nul/Err 调用 Get/Expect 等方法导致的 panic
+}
+
+// 开启计数，默认关闭
+func Enable() {
+	stats.Enabled.Store(true)
+}
+
+// 关闭计数，已有的计数保持不变
+func Disable() {
+	stats.Enabled.Store(false)
+}
+
+// 当前所有计数器的值
+func Read() Snapshot {
+	return Snapshot{
+		Somes:          stats.Somes.Load(),
+		Nones:          stats.Nones.Load(),
+		Oks:            stats.Oks.Load(),
+		Errs:           stats.Errs.Load(),
+		CapturedPanics: stats.CapturedPanics.Load(),
+		FailedUnwraps:  stats.FailedUnwraps.Load(),
+	}
+}
+
+// 将所有计数器清零
+func Reset() {
+	for _, c := range []*atomic.Uint64{
+		&stats.Somes, &stats.Nones, &stats.Oks, &stats.Errs, &stats.CapturedPanics, &stats.FailedUnwraps,
+	} {
+		c.Store(0)
+	}
+}
+
+// 以 name 为名通过 expvar 发布计数器快照。Prometheus 等其他系统可以通过 Read 自行导出
+func Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() any { return Read() }))
+}
+
+// ========================== 按调用处计数 =============================
+
+// 一个调用处构造 Ok/Err 的采样计数
+type CallSite struct {
+	File string
+	Line int
+	Func string
+	Oks  uint64
+	Errs uint64
+}
+
+// 开启按调用处计数，每构造 every 个 Ok/Err 采样一次（1 表示全部记录），every <= 0 时关闭。
+// 调用处为调用栈中第一个不属于本库的函数，采样时需要遍历调用栈，开销较大
+func EnableCallSites(every int) {
+	stats.SiteEvery.Store(int64(every))
+}
+
+// 关闭按调用处计数，已有的计数保持不变
+func DisableCallSites() {
+	stats.SiteEvery.Store(0)
+}
+
+// 所有调用处的采样计数，按 Errs 从多到少排序。实际数量约为计数乘以采样间隔
+func CallSites() []CallSite {
+	var sites []CallSite
+	stats.Sites.Range(func(k, v any) bool {
+		key, c := k.(stats.SiteKey), v.(*stats.SiteCounts)
+		sites = append(sites, CallSite{File: key.File, Line: key.Line, Func: c.Func, Oks: c.Oks.Load(), Errs: c.Errs.Load()})
+		return true
+	})
+	slices.SortFunc(sites, func(a, b CallSite) int {
+		return cmp.Or(cmp.Compare(b.Errs, a.Errs), cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line))
+	})
+	return sites
+}
+
+// 清除所有调用处的计数
+func ResetCallSites() {
+	stats.Sites.Clear()
+}
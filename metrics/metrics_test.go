@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"errors"
+	"expvar"
+	"strings"
+	"testing"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+	"github.com/viocha/go-option/util"
+)
+
+func TestMetrics(t *testing.T) {
+	Reset()
+	opt.Val(1)
+	if got := Read(); got != (Snapshot{}) {
+		t.Errorf("Expected no counts while disabled, got %+v", got)
+	}
+
+	Enable()
+	defer Disable()
+	opt.Val(1)
+	opt.Nul[int]()
+	result.Ok(1)
+	result.Err[int](errors.New("boom"))
+	result.FromFunc(func() int { util.MustNil(errors.New("must")); return 0 })
+	func() {
+		defer func() { recover() }()
+		opt.Nul[int]().Get()
+	}()
+
+	got := Read()
+	if got.Somes != 1 || got.Nones != 2 || got.Oks != 1 || got.CapturedPanics != 1 || got.FailedUnwraps != 1 || got.Errs < 2 {
+		t.Errorf("Unexpected counts: %+v", got)
+	}
+
+	Publish("option_metrics")
+	if v := expvar.Get("option_metrics"); v == nil || !strings.Contains(v.String(), `"Somes":1`) {
+		t.Errorf("Expected the snapshot to be published, got %v", v)
+	}
+}
+
+func TestCallSites(t *testing.T) {
+	ResetCallSites()
+	EnableCallSites(1)
+	defer DisableCallSites()
+	for range 3 {
+		result.From(0, errors.New("boom")) // 经由库内的 From 构造，应记录为此处
+	}
+	result.Ok(1)
+	DisableCallSites()
+	result.Err[int](errors.New("not sampled"))
+
+	sites := CallSites()
+	if len(sites) != 2 {
+		t.Fatalf("Expected 2 call sites, got %+v", sites)
+	}
+	if s := sites[0]; s.Errs != 3 || s.Oks != 0 || !strings.HasSuffix(s.File, "metrics_test.go") || !strings.HasSuffix(s.Func, "TestCallSites") {
+		t.Errorf("Unexpected first call site: %+v", s)
+	}
+	if s := sites[1]; s.Oks != 1 || s.Errs != 0 || s.Line != sites[0].Line+2 {
+		t.Errorf("Unexpected second call site: %+v", s)
+	}
+
+	ResetCallSites()
+	if sites := CallSites(); len(sites) != 0 {
+		t.Errorf("Expected no call sites after reset, got %+v", sites)
+	}
+}
+
+func TestMetrics_Propagation(t *testing.T) {
+	Reset()
+	ResetCallSites()
+	Enable()
+	EnableCallSites(1)
+	defer Disable()
+	defer DisableCallSites()
+
+	r := result.Err[int](errors.New("boom"))
+	for range 4 {
+		r = result.Then(r, func(v int) result.Result[int] { return result.Ok(v) })
+	}
+	if got := Read().Errs; got != 1 {
+		t.Errorf("Expected one Err for a propagated error, got %d", got)
+	}
+	if sites := CallSites(); len(sites) != 1 || sites[0].Errs != 1 {
+		t.Errorf("Expected a single call site with one Err, got %+v", sites)
+	}
+}
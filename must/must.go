@@ -0,0 +1,28 @@
+package must
+
+import (
+	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/util"
+)
+
+// 与 util 中的 Must 系列函数相同，名称更短，适合以 must.Get(...) 的形式调用
+
+func Nil(err error) { util.MustNil(err) }
+
+func Get[T any](v T, err error) T { return util.MustGet(v, err) }
+
+func Get2[T1, T2 any](v1 T1, v2 T2, err error) (T1, T2) { return util.MustGet2(v1, v2, err) }
+
+func Get3[T1, T2, T3 any](v1 T1, v2 T2, v3 T3, err error) (T1, T2, T3) {
+	return util.MustGet3(v1, v2, v3, err)
+}
+
+func Get4[T1, T2, T3, T4 any](v1 T1, v2 T2, v3 T3, v4 T4, err error) (T1, T2, T3, T4) {
+	return util.MustGet4(v1, v2, v3, v4, err)
+}
+
+// 执行 f，捕获其中由 Must 系列函数产生的 panic 并以 error 返回，其他 panic 原样传播。
+// 与 Option/Result 的链式方法使用相同的规则，策略为 util.PropagatePanics 时不捕获任何 panic
+func Catch(f func()) error {
+	return must.CatchMustPanic(f)
+}
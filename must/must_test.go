@@ -0,0 +1,44 @@
+package must
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/viocha/go-option/util"
+)
+
+func four() (int, string, bool, float64, error) { return 1, "a", true, 1.5, nil }
+
+func TestGet(t *testing.T) {
+	a, b, c := Get3(1, "a", true, nil)
+	if a != 1 || b != "a" || !c {
+		t.Errorf("Unexpected values %v %v %v", a, b, c)
+	}
+	errFail := errors.New("fail")
+	err := Catch(func() {
+		Get4(four())
+		Get4(1, 2, 3, 4, errFail)
+	})
+	if !errors.Is(err, errFail) || !errors.Is(err, util.ErrMust) {
+		t.Errorf("Expected the error to be captured, got %v", err)
+	}
+}
+
+func TestCatch_OtherPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected non-Must panics to propagate")
+		}
+	}()
+	Catch(func() { panic("boom") })
+}
+
+func TestMustGetAt(t *testing.T) {
+	err := Catch(func() {
+		util.MustGetAt(0, errors.New("fail"))
+	})
+	if err == nil || !strings.Contains(err.Error(), "must_test.go:") {
+		t.Errorf("Expected the caller's location in the error, got %v", err)
+	}
+}
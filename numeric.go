@@ -0,0 +1,59 @@
+package option
+
+import "math"
+
+// ========================== 数值 =============================
+
+type Float interface {
+	~float32 | ~float64
+}
+
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		Float
+}
+
+// 返回一个比较器，两个值之差的绝对值不超过 epsilon 时视为相等
+func Near[T Float](epsilon float64) func(a, b T) bool {
+	return func(a, b T) bool {
+		return math.Abs(float64(a)-float64(b)) <= epsilon
+	}
+}
+
+// 判断是否存在与 v 之差不超过 epsilon 的值
+func HasNear[T Float](o Option[T], v T, epsilon float64) bool {
+	return o.HasWith(v, Near[T](epsilon))
+}
+
+// ========================== 算术运算 =============================
+
+// a、b 均存在值时返回 a + b，否则返回 Nul
+func Add[T Number](a, b Option[T]) Option[T] {
+	return lift(a, b, func(x, y T) T { return x + y })
+}
+
+// a、b 均存在值时返回 a - b，否则返回 Nul
+func Sub[T Number](a, b Option[T]) Option[T] {
+	return lift(a, b, func(x, y T) T { return x - y })
+}
+
+// a、b 均存在值时返回 a * b，否则返回 Nul
+func Mul[T Number](a, b Option[T]) Option[T] {
+	return lift(a, b, func(x, y T) T { return x * y })
+}
+
+// a、b 均存在值且 b 不为 0 时返回 a / b，否则返回 Nul
+func Div[T Number](a, b Option[T]) Option[T] {
+	if b.IsVal() && b.Get() == 0 {
+		return Nul[T]()
+	}
+	return lift(a, b, func(x, y T) T { return x / y })
+}
+
+func lift[T any](a, b Option[T], op func(x, y T) T) Option[T] {
+	if a.IsNul() || b.IsNul() {
+		return Nul[T]()
+	}
+	return Val(op(a.Get(), b.Get()))
+}
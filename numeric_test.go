@@ -0,0 +1,46 @@
+package option
+
+import "testing"
+
+func TestHasNear(t *testing.T) {
+	a, b := 0.1, 0.2
+	o := Val(a + b)
+	if o.Has(0.3) {
+		t.Errorf("Expected Has to compare exactly, got %v", o)
+	}
+	if !HasNear(o, 0.3, 1e-9) {
+		t.Errorf("Expected %v to be near 0.3", o)
+	}
+	if HasNear(o, 0.4, 1e-9) {
+		t.Errorf("Expected %v not to be near 0.4", o)
+	}
+	if HasNear(Nul[float64](), 0, 1) {
+		t.Error("Expected HasNear on None to return false")
+	}
+
+	if !Val[float32](1.0).HasWith(1.05, Near[float32](0.1)) {
+		t.Error("Expected HasWith with Near comparator to match")
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	if o := Add(Val(1), Val(2)); !o.Has(3) {
+		t.Errorf("Expected Some(3), got %v", o)
+	}
+	if o := Sub(Val(1), Nul[int]()); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if o := Mul(Val(1.5), Val(2.0)); !o.Has(3.0) {
+		t.Errorf("Expected Some(3), got %v", o)
+	}
+	if o := Div(Val(7), Val(2)); !o.Has(3) {
+		t.Errorf("Expected Some(3), got %v", o)
+	}
+	if o := Div(Val(1.0), Val(0.0)); o.IsVal() {
+		t.Errorf("Expected None when dividing by zero, got %v", o)
+	}
+	// 缺失任一输入时整个表达式缺失
+	if o := Div(Add(Val(1), Nul[int]()), Val(2)); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
@@ -4,22 +4,29 @@ import (
 	"fmt"
 	"reflect"
 	
+	"github.com/viocha/go-option/internal/common"
 	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/internal/stats"
+	"github.com/viocha/go-option/util"
 )
 
+// 值直接保存在结构体中，因此 T 可比较时 Option[T] 也可比较，可以用作 map 的键。
+// 零值 Option[T]{} 就是 Nul[T]()，声明后未赋值的 Option 字段和变量都是合法的 Nul
 type Option[T any] struct {
-	val    *T
+	val    T
 	exists bool
 }
 
 // ========================== 构造函数 =============================
 
 func Val[T any](value T) Option[T] {
-	return Option[T]{val: &value, exists: true}
+	stats.Inc(&stats.Somes)
+	return Option[T]{val: value, exists: true}
 }
 
 func Nul[T any]() Option[T] {
-	return Option[T]{val: nil, exists: false}
+	stats.Inc(&stats.Nones)
+	return Option[T]{}
 }
 
 func From[T any](val T, err error) Option[T] {
@@ -29,6 +36,14 @@ func From[T any](val T, err error) Option[T] {
 	return Val(val)
 }
 
+// 对应 comma-ok 惯用法，ok 为 false 时返回 Nul
+func FromOk[T any](val T, ok bool) Option[T] {
+	if !ok {
+		return Nul[T]()
+	}
+	return Val(val)
+}
+
 func FromPtr[T any](val *T) Option[T] {
 	if val == nil {
 		return Nul[T]()
@@ -36,6 +51,41 @@ func FromPtr[T any](val *T) Option[T] {
 	return Val(*val)
 }
 
+// 零值视为不存在值
+func FromNonZero[T comparable](v T) Option[T] {
+	var zero T
+	if v == zero {
+		return Nul[T]()
+	}
+	return Val(v)
+}
+
+// 空字符串视为不存在值
+func FromString(s string) Option[string] {
+	return FromNonZero(s)
+}
+
+// cond 为 true 时返回 Val(v)，否则返回 Nul
+func When[T any](cond bool, v T) Option[T] {
+	if !cond {
+		return Nul[T]()
+	}
+	return Val(v)
+}
+
+// cond 为 true 时以 f 的返回值构造 Val，否则不调用 f 并返回 Nul。与 FromFunc 一样捕获 ErrMust panic
+func WhenFunc[T any](cond bool, f func() T) Option[T] {
+	if !cond {
+		return Nul[T]()
+	}
+	return FromFunc(f)
+}
+
+// cond 为 false 时返回 Val(v)，否则返回 Nul
+func Unless[T any](cond bool, v T) Option[T] {
+	return When(!cond, v)
+}
+
 func FromFunc[T any](f func() T) Option[T] {
 	var result Option[T]
 	if nil == must.CatchMustPanic(func() {
@@ -46,22 +96,63 @@ func FromFunc[T any](f func() T) Option[T] {
 	return Nul[T]()
 }
 
+// 调用返回 (T, bool) 的函数，ok 为 false 时返回 Nul。与 FromFunc 不同，不捕获 panic（包括 util.MustXxx 产生的）
+func FromFuncOk[T any](f func() (T, bool)) Option[T] {
+	return FromOk(f())
+}
+
+// 对 v 进行类型断言，断言失败时返回 Nul
+func As[T any](v any) Option[T] {
+	tv, ok := v.(T)
+	if !ok {
+		return Nul[T]()
+	}
+	return Val(tv)
+}
+
 // ========================== 方法 =============================
 
 func (o Option[T]) String() string {
 	if o.IsVal() {
-		return fmt.Sprintf("Some[%T](%v)", o.Get(), o.Get())
+		return "Some[" + common.TypeName(o.val) + "](" + fmt.Sprint(o.val) + ")"
+	}
+	return "None[" + common.TypeName(o.val) + "]()"
+}
+
+// 实现 fmt.Formatter：%#v 输出构造函数形式，其余动词作用于内部的值
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	name := common.TypeName(o.val)
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		if o.IsVal() {
+			fmt.Fprintf(f, "option.Val[%s](%#v)", name, o.val)
+		} else {
+			fmt.Fprintf(f, "option.Nul[%s]()", name)
+		}
+	case o.IsVal():
+		fmt.Fprintf(f, "Some[%s](", name)
+		common.FormatValue(f, verb, o.val)
+		fmt.Fprint(f, ")")
+	default:
+		fmt.Fprintf(f, "None[%s]()", name)
 	}
-	typ := reflect.TypeFor[T]()
-	return fmt.Sprintf("None[%v]()", typ)
 }
 
-// 克隆
+// 克隆。值直接保存在 Option 中，复制 Option 即复制值本身；
+// 值的类型实现了 Clone() T 时使用该方法深拷贝，否则为浅拷贝（切片、map、指针等仍与原值共享底层数据）
 func (o Option[T]) Clone() Option[T] {
 	if o.IsNul() {
 		return Nul[T]()
 	}
-	return Val(*o.val)
+	return Val(common.Clone(o.val))
+}
+
+// 使用 clone 复制存在的值
+func (o Option[T]) CloneFunc(clone func(T) T) Option[T] {
+	if o.IsNul() {
+		return Nul[T]()
+	}
+	return Val(clone(o.val))
 }
 
 // 存在值
@@ -74,12 +165,17 @@ func (o Option[T]) IsNul() bool {
 	return !o.exists
 }
 
-// 判断是否存在指定值，使用 reflect.DeepEqual 进行比较
+// 判断是否存在指定值，使用 reflect.DeepEqual 的语义进行比较（对可比较的简单类型使用 ==）
 func (o Option[T]) Has(value T) bool {
 	if o.IsNul() {
 		return false
 	}
-	return reflect.DeepEqual(o.Get(), value)
+	return common.Equal(o.Get(), value)
+}
+
+// 判断是否存在指定值，使用 eq 进行比较
+func (o Option[T]) HasWith(value T, eq func(a, b T) bool) bool {
+	return o.IsVal() && eq(o.Get(), value)
 }
 
 // 判断是否存在满足条件的值
@@ -92,9 +188,19 @@ func (o Option[T]) HasFunc(f func(T) bool) bool {
 // 如果存在值，则返回该值。否则 panic。
 func (o Option[T]) Get() T {
 	if o.IsNul() {
-		panic("called Option.Unwrap() on a None value")
+		stats.Inc(&stats.FailedUnwraps)
+		panic("called Option.Get() on a None value")
+	}
+	return o.val
+}
+
+// 如果存在值，则返回该值。否则以 msg 为消息 panic
+func (o Option[T]) Expect(msg string) T {
+	if o.IsNul() {
+		stats.Inc(&stats.FailedUnwraps)
+		panic(fmt.Sprintf("%s: expected a value, got None[%v]", msg, reflect.TypeFor[T]()))
 	}
-	return *o.val
+	return o.val
 }
 
 func (o Option[T]) GetOr(value T) T {
@@ -118,9 +224,15 @@ func (o Option[T]) GetOrZero() T {
 	return *new(T)
 }
 
+// 以 comma-ok 形式返回值，不存在值时返回零值和 false，是 FromOk 的逆操作
+func (o Option[T]) ToOk() (T, bool) {
+	return o.val, o.exists
+}
+
+// 返回指向值的副本的指针，不存在值时返回 nil
 func (o Option[T]) ToPtr() *T {
 	if o.IsVal() {
-		return o.val
+		return &o.val
 	}
 	return nil
 }
@@ -132,13 +244,43 @@ func (o Option[T]) ToErr(e error) error {
 	return e
 }
 
-func (o Option[T]) Unwrap(err error) (T, error) {
+// 同时返回值和错误：存在值时返回值和 nil，否则返回零值和 err
+func (o Option[T]) Unpack(err error) (T, error) {
 	if o.IsVal() {
 		return o.Get(), nil
 	}
 	return *new(T), err
 }
 
+// Deprecated: 使用 Unpack
+func (o Option[T]) Unwrap(err error) (T, error) {
+	return o.Unpack(err)
+}
+
+// ============================= 原地修改 ================================
+
+// 取出当前的 Option，并将自身置为 Nul
+func (o *Option[T]) Take() Option[T] {
+	old := *o
+	*o = Nul[T]()
+	return old
+}
+
+// 将自身替换为 Val(v)，返回原来的 Option
+func (o *Option[T]) Replace(v T) Option[T] {
+	old := *o
+	*o = Val(v)
+	return old
+}
+
+// 不存在值时先设置为 Val(v)，然后返回指向内部值的指针
+func (o *Option[T]) GetOrInsert(v T) *T {
+	if o.IsNul() {
+		*o = Val(v)
+	}
+	return &o.val
+}
+
 // ============================= 链式方法 ================================
 
 func (o Option[T]) Try(f func(T)) Option[T] {
@@ -163,6 +305,22 @@ func (o Option[T]) Catch(f func()) Option[T] {
 	return Nul[T]()
 }
 
+// 存在值时以该值调用 f 并原样返回 o。与 Try 不同，不捕获任何 panic，也不改变 o
+func (o Option[T]) Inspect(f func(T)) Option[T] {
+	if o.IsVal() {
+		f(o.val)
+	}
+	return o
+}
+
+// 不存在值时调用 f 并原样返回 o。与 Catch 不同，不捕获任何 panic，也不改变 o
+func (o Option[T]) InspectNul(f func()) Option[T] {
+	if o.IsNul() {
+		f()
+	}
+	return o
+}
+
 func (o Option[T]) Finally(f func()) Option[T] {
 	if nil == must.CatchMustPanic(f) {
 		return o
@@ -185,6 +343,17 @@ func (o Option[T]) Filter(f func(T) bool) Option[T] {
 	return Nul[T]()
 }
 
+// 断言存在的值满足 pred，不满足时返回 Nul。开启 util.SetDebugAssertions 时以 *util.AssertionError panic
+func (o Option[T]) Assert(pred func(T) bool, msg string) Option[T] {
+	if o.IsNul() || pred(o.Get()) {
+		return o
+	}
+	if util.DebugAssertions() {
+		panic(&util.AssertionError{Msg: msg, Value: o.Get()})
+	}
+	return Nul[T]()
+}
+
 // 不存在值时，执行给定的函数构造一个新的 Option
 func (o Option[T]) Else(f func() Option[T]) Option[T] {
 	if o.IsVal() {
@@ -212,21 +381,12 @@ func (o Option[T]) ElseVal(f func() T) Option[T] {
 	return Nul[T]()
 }
 
-// ================================ 常用类型的逻辑与方法 =============================
-
-func (o Option[T]) ThenT(f func(T) Option[T]) Option[T]                 { return Then(o, f) }
-func (o Option[T]) ThenInt(f func(T) Option[int]) Option[int]           { return Then(o, f) }
-func (o Option[T]) ThenFloat(f func(T) Option[float64]) Option[float64] { return Then(o, f) }
-func (o Option[T]) ThenStr(f func(T) Option[string]) Option[string]     { return Then(o, f) }
-func (o Option[T]) ThenBool(f func(T) Option[bool]) Option[bool]        { return Then(o, f) }
+// ================================ 同类型的链式方法 =============================
 
-// ================================ 常用类型的Map方法 =============================
+// 使用 Then/Map 函数可以转换为任意类型；需要固定目标类型的适配函数时，可以使用 cmd/optiongen 生成
 
-func (o Option[T]) MapT(f func(T) T) Option[T]                 { return Map(o, f) }
-func (o Option[T]) MapInt(f func(T) int) Option[int]           { return Map(o, f) }
-func (o Option[T]) MapFloat(f func(T) float64) Option[float64] { return Map(o, f) }
-func (o Option[T]) MapStr(f func(T) string) Option[string]     { return Map(o, f) }
-func (o Option[T]) MapBool(f func(T) bool) Option[bool]        { return Map(o, f) }
+func (o Option[T]) ThenT(f func(T) Option[T]) Option[T] { return Then(o, f) }
+func (o Option[T]) MapT(f func(T) T) Option[T]           { return Map(o, f) }
 
 // ================================ 逻辑与  =============================
 
@@ -244,8 +404,69 @@ func Then[T any, U any](o Option[T], f func(T) Option[U]) Option[U] {
 	return Nul[U]()
 }
 
+// 与 Then 相同，但捕获 f 中的任意 panic（不受 util.SetPanicPolicy 影响），并以 *util.PanicError 的形式作为 error 返回，
+// 而不是只返回 Nul
+func TryThen[T any, U any](o Option[T], f func(T) Option[U]) (Option[U], error) {
+	if o.IsNul() {
+		return Nul[U](), nil
+	}
+	var result Option[U]
+	if err := must.CatchAnyPanic(func() {
+		result = f(o.Get())
+	}); err != nil {
+		return Nul[U](), err
+	}
+	return result, nil
+}
+
+// 存在值时调用返回 (U, bool) 的函数，ok 为 false 时返回 Nul
+func ThenOk[T any, U any](o Option[T], f func(T) (U, bool)) Option[U] {
+	return Then(o, func(v T) Option[U] { return FromOk(f(v)) })
+}
+
+// 存在值时调用返回 (U, error) 的函数，返回错误时为 Nul
+func ThenFrom[T any, U any](o Option[T], f func(T) (U, error)) Option[U] {
+	return Then(o, func(v T) Option[U] { return From(f(v)) })
+}
+
+// 展开嵌套的 Option
+func Flatten[T any](o Option[Option[T]]) Option[T] {
+	if o.IsNul() {
+		return Nul[T]()
+	}
+	return o.Get()
+}
+
+// ================================ 逻辑或  =============================
+
+// 返回第一个存在值的 Option，都不存在值时返回 Nul
+func Coalesce[T any](opts ...Option[T]) Option[T] {
+	for _, o := range opts {
+		if o.IsVal() {
+			return o
+		}
+	}
+	return Nul[T]()
+}
+
+// 依次调用 fns，返回第一个存在值的结果，之后的函数不会被调用。函数中的 ErrMust panic 视为 Nul
+func CoalesceFunc[T any](fns ...func() Option[T]) Option[T] {
+	for _, f := range fns {
+		if o := Flatten(FromFunc(f)); o.IsVal() {
+			return o
+		}
+	}
+	return Nul[T]()
+}
+
 // =============================== Map操作 =============================
 
+// 与 Map 相同，但捕获 f 中的任意 panic（不受 util.SetPanicPolicy 影响），并以 *util.PanicError 的形式作为 error 返回，
+// 而不是只返回 Nul
+func TryMap[T any, U any](o Option[T], f func(T) U) (Option[U], error) {
+	return TryThen(o, func(v T) Option[U] { return Val(f(v)) })
+}
+
 // 若存在值，则使用f转换该值，构造一个 Option
 func Map[T any, U any](o Option[T], f func(T) U) Option[U] {
 	if o.IsNul() {
@@ -260,6 +481,30 @@ func Map[T any, U any](o Option[T], f func(T) U) Option[U] {
 	return Nul[U]()
 }
 
+// 若 a、b 均存在值，则使用f组合这些值，构造一个 Option
+func Map2[A, B, U any](a Option[A], b Option[B], f func(A, B) U) Option[U] {
+	if a.IsNul() || b.IsNul() {
+		return Nul[U]()
+	}
+	return FromFunc(func() U { return f(a.Get(), b.Get()) })
+}
+
+// 若 a、b、c 均存在值，则使用f组合这些值，构造一个 Option
+func Map3[A, B, C, U any](a Option[A], b Option[B], c Option[C], f func(A, B, C) U) Option[U] {
+	if a.IsNul() || b.IsNul() || c.IsNul() {
+		return Nul[U]()
+	}
+	return FromFunc(func() U { return f(a.Get(), b.Get(), c.Get()) })
+}
+
+// 若 a、b、c、d 均存在值，则使用f组合这些值，构造一个 Option
+func Map4[A, B, C, D, U any](a Option[A], b Option[B], c Option[C], d Option[D], f func(A, B, C, D) U) Option[U] {
+	if a.IsNul() || b.IsNul() || c.IsNul() || d.IsNul() {
+		return Nul[U]()
+	}
+	return FromFunc(func() U { return f(a.Get(), b.Get(), c.Get(), d.Get()) })
+}
+
 // 若存在值，则使用f转换该值并返回，否则返回给定的默认值
 func MapOr[T any, U any](o Option[T], f func(T) U, v U) U {
 	var result U
@@ -287,3 +532,36 @@ func MapOrFunc[T any, U any](o Option[T], okFn func(T) U, defaultFn func() U) U
 	}
 	return defaultFn()
 }
+
+// =============================== 模式匹配 =============================
+
+// 存在值时返回 someFn 的结果，否则返回 noneFn 的结果，不捕获任何 panic
+func Match[T any, U any](o Option[T], someFn func(T) U, noneFn func() U) U {
+	if o.IsVal() {
+		return someFn(o.Get())
+	}
+	return noneFn()
+}
+
+// 存在值时调用 someFn，否则调用 noneFn，不捕获任何 panic
+func MatchDo[T any](o Option[T], someFn func(T), noneFn func()) {
+	if o.IsVal() {
+		someFn(o.Get())
+		return
+	}
+	noneFn()
+}
+
+// 处理 Option 所有情况的访问者，参见 result.ResultVisitor
+type OptionVisitor[T any, U any] interface {
+	SomeCase(v T) U
+	NoneCase() U
+}
+
+// 根据 o 的情况调用 v 中对应的方法，不捕获任何 panic
+func Visit[T any, U any](o Option[T], v OptionVisitor[T, U]) U {
+	if o.IsVal() {
+		return v.SomeCase(o.val)
+	}
+	return v.NoneCase()
+}
@@ -0,0 +1,159 @@
+package option
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/viocha/go-option/tuple"
+)
+
+// 同时存在或同时不存在的两个值
+type Option2[A, B any] struct {
+	a      A
+	b      B
+	exists bool
+}
+
+// 同时存在或同时不存在的三个值
+type Option3[A, B, C any] struct {
+	a      A
+	b      B
+	c      C
+	exists bool
+}
+
+// ========================== 构造函数 =============================
+
+func Val2[A, B any](a A, b B) Option2[A, B] {
+	return Option2[A, B]{a: a, b: b, exists: true}
+}
+
+func Nul2[A, B any]() Option2[A, B] {
+	return Option2[A, B]{}
+}
+
+func Val3[A, B, C any](a A, b B, c C) Option3[A, B, C] {
+	return Option3[A, B, C]{a: a, b: b, c: c, exists: true}
+}
+
+func Nul3[A, B, C any]() Option3[A, B, C] {
+	return Option3[A, B, C]{}
+}
+
+// 所有 Option 都存在值时，组合为 Option2，否则返回 Nul2
+func Zip[A, B any](a Option[A], b Option[B]) Option2[A, B] {
+	if a.IsNul() || b.IsNul() {
+		return Nul2[A, B]()
+	}
+	return Val2(a.Get(), b.Get())
+}
+
+// 所有 Option 都存在值时，组合为 Option3，否则返回 Nul3
+func Zip3[A, B, C any](a Option[A], b Option[B], c Option[C]) Option3[A, B, C] {
+	if a.IsNul() || b.IsNul() || c.IsNul() {
+		return Nul3[A, B, C]()
+	}
+	return Val3(a.Get(), b.Get(), c.Get())
+}
+
+// ========================== Option2 方法 =============================
+
+func (o Option2[A, B]) String() string {
+	if o.IsVal() {
+		return fmt.Sprintf("Some[%T, %T](%v, %v)", o.a, o.b, o.a, o.b)
+	}
+	return fmt.Sprintf("None[%v, %v]()", reflect.TypeFor[A](), reflect.TypeFor[B]())
+}
+
+func (o Option2[A, B]) IsVal() bool {
+	return o.exists
+}
+
+func (o Option2[A, B]) IsNul() bool {
+	return !o.exists
+}
+
+// 如果存在值，则返回这些值。否则 panic。
+func (o Option2[A, B]) Get() (A, B) {
+	if o.IsNul() {
+		panic("called Option2.Get() on a None value")
+	}
+	return o.a, o.b
+}
+
+// 返回所有值以及是否存在值，不存在时返回零值
+func (o Option2[A, B]) Unpack() (A, B, bool) {
+	return o.a, o.b, o.exists
+}
+
+// 拆分为两个 Option
+func (o Option2[A, B]) Unzip() (Option[A], Option[B]) {
+	if o.IsNul() {
+		return Nul[A](), Nul[B]()
+	}
+	return Val(o.a), Val(o.b)
+}
+
+// ========================== Option3 方法 =============================
+
+func (o Option3[A, B, C]) String() string {
+	if o.IsVal() {
+		return fmt.Sprintf("Some[%T, %T, %T](%v, %v, %v)", o.a, o.b, o.c, o.a, o.b, o.c)
+	}
+	return fmt.Sprintf("None[%v, %v, %v]()", reflect.TypeFor[A](), reflect.TypeFor[B](), reflect.TypeFor[C]())
+}
+
+func (o Option3[A, B, C]) IsVal() bool {
+	return o.exists
+}
+
+func (o Option3[A, B, C]) IsNul() bool {
+	return !o.exists
+}
+
+// 如果存在值，则返回这些值。否则 panic。
+func (o Option3[A, B, C]) Get() (A, B, C) {
+	if o.IsNul() {
+		panic("called Option3.Get() on a None value")
+	}
+	return o.a, o.b, o.c
+}
+
+// 返回所有值以及是否存在值，不存在时返回零值
+func (o Option3[A, B, C]) Unpack() (A, B, C, bool) {
+	return o.a, o.b, o.c, o.exists
+}
+
+// 拆分为三个 Option
+func (o Option3[A, B, C]) Unzip() (Option[A], Option[B], Option[C]) {
+	if o.IsNul() {
+		return Nul[A](), Nul[B](), Nul[C]()
+	}
+	return Val(o.a), Val(o.b), Val(o.c)
+}
+
+// ========================== 元组 =============================
+
+// 将存在的 Pair 拆分为两个 Option
+func Unzip[A, B any](o Option[tuple.Pair[A, B]]) (Option[A], Option[B]) {
+	if o.IsNul() {
+		return Nul[A](), Nul[B]()
+	}
+	return Val(o.val.First), Val(o.val.Second)
+}
+
+// 将存在的 Triple 拆分为三个 Option
+func Unzip3[A, B, C any](o Option[tuple.Triple[A, B, C]]) (Option[A], Option[B], Option[C]) {
+	if o.IsNul() {
+		return Nul[A](), Nul[B](), Nul[C]()
+	}
+	return Val(o.val.First), Val(o.val.Second), Val(o.val.Third)
+}
+
+// 转换为 Option[tuple.Pair[A, B]]
+func (o Option2[A, B]) ToPair() Option[tuple.Pair[A, B]] {
+	if o.IsNul() {
+		return Nul[tuple.Pair[A, B]]()
+	}
+	return Val(tuple.NewPair(o.a, o.b))
+}
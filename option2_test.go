@@ -0,0 +1,68 @@
+package option
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/viocha/go-option/tuple"
+)
+
+func TestZip(t *testing.T) {
+	o := Zip(Val(1), Val("a"))
+	a, b, ok := o.Unpack()
+	if !ok || a != 1 || b != "a" {
+		t.Errorf("Expected (1, a, true), got (%v, %v, %v)", a, b, ok)
+	}
+	if !strings.HasPrefix(o.String(), "Some[int, string]") {
+		t.Errorf("Unexpected string representation: %s", o)
+	}
+
+	n := Zip(Val(1), Nul[string]())
+	if n.IsVal() {
+		t.Errorf("Expected None when any input is None, got %v", n)
+	}
+	if _, _, ok := n.Unpack(); ok {
+		t.Error("Expected Unpack on None to return ok == false")
+	}
+	if !strings.HasPrefix(n.String(), "None[int, string]") {
+		t.Errorf("Unexpected string representation: %s", n)
+	}
+}
+
+func TestZip3(t *testing.T) {
+	o := Zip3(Val(1), Val("a"), Val(true))
+	a, b, c := o.Get()
+	if a != 1 || b != "a" || !c {
+		t.Errorf("Expected (1, a, true), got (%v, %v, %v)", a, b, c)
+	}
+	oa, ob, oc := o.Unzip()
+	if !oa.Has(1) || !ob.Has("a") || !oc.Has(true) {
+		t.Errorf("Expected Unzip to return Some values, got %v %v %v", oa, ob, oc)
+	}
+
+	n := Zip3(Val(1), Val("a"), Nul[bool]())
+	if n.IsVal() {
+		t.Errorf("Expected None when any input is None, got %v", n)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic on Get() from None")
+		}
+	}()
+	n.Get()
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := Unzip(Zip(Val(1), Val("x")).ToPair())
+	if !a.Has(1) || !b.Has("x") {
+		t.Errorf("Expected Some(1), Some(x), got %v %v", a, b)
+	}
+	a, b = Unzip(Nul[tuple.Pair[int, string]]())
+	if a.IsVal() || b.IsVal() {
+		t.Errorf("Expected None, None, got %v %v", a, b)
+	}
+	_, _, c := Unzip3(Val(tuple.NewTriple(1, "x", true)))
+	if !c.Has(true) {
+		t.Errorf("Expected Some(true), got %v", c)
+	}
+}
@@ -3,8 +3,12 @@ package option
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
+
+	"github.com/viocha/go-option/util"
 )
 
 func TestSomeAndNone(t *testing.T) {
@@ -115,6 +119,20 @@ func TestOption_GetValErr(t *testing.T) {
 	}
 }
 
+func TestOption_Unpack(t *testing.T) {
+	errMsg := errors.New("missing")
+	for _, o := range []Option[int]{Nul[int](), Val(5), {}} {
+		v, err := o.Unpack(errMsg)
+		oldV, oldErr := o.Unwrap(errMsg)
+		if v != oldV || err != oldErr {
+			t.Errorf("Expected Unwrap to match Unpack for %v", o)
+		}
+		if o.IsNul() && (v != 0 || err != errMsg) {
+			t.Errorf("Expected zero value and error on None, got %v, %v", v, err)
+		}
+	}
+}
+
 func TestOption_Map(t *testing.T) {
 	opt := Val(2)
 	mapped := Map(opt, func(x int) string { return fmt.Sprintf("num:%d", x) })
@@ -236,3 +254,363 @@ func TestOption_GetOrZero(t *testing.T) {
 		t.Errorf("Expected GetOrZero on None[struct] to return zero struct, got %v", noneStruct.GetOrZero())
 	}
 }
+
+func TestOption_MapN(t *testing.T) {
+	sum := Map2(Val(1), Val(2), func(a, b int) int { return a + b })
+	if !sum.Has(3) {
+		t.Errorf("Expected Map2 to return Some(3), got %v", sum)
+	}
+	if Map2(Val(1), Nul[int](), func(a, b int) int { return a + b }).IsVal() {
+		t.Error("Expected Map2 with a None input to return None")
+	}
+
+	s := Map3(Val("a"), Val(1), Val(true), func(a string, b int, c bool) string {
+		return fmt.Sprintf("%s%d%v", a, b, c)
+	})
+	if !s.Has("a1true") {
+		t.Errorf("Expected Map3 to return Some(a1true), got %v", s)
+	}
+
+	n := Map4(Val(1), Val(2), Val(3), Nul[int](), func(a, b, c, d int) int {
+		t.Error("Map4 func called with a None input")
+		return 0
+	})
+	if n.IsVal() {
+		t.Error("Expected Map4 with a None input to return None")
+	}
+}
+
+func TestOption_Flatten(t *testing.T) {
+	if o := Flatten(Val(Val(1))); !o.Has(1) {
+		t.Errorf("Expected Some(1), got %v", o)
+	}
+	if o := Flatten(Val(Nul[int]())); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if o := Flatten(Nul[Option[int]]()); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
+
+func TestOption_Match(t *testing.T) {
+	some := Match(Val(2), func(v int) string { return fmt.Sprint(v * 2) }, func() string { return "none" })
+	if some != "4" {
+		t.Errorf("Expected 4, got %s", some)
+	}
+	none := Match(Nul[int](), func(v int) string { return "some" }, func() string { return "none" })
+	if none != "none" {
+		t.Errorf("Expected none, got %s", none)
+	}
+
+	called := ""
+	MatchDo(Nul[int](), func(int) { called = "some" }, func() { called = "none" })
+	if called != "none" {
+		t.Errorf("Expected noneFn to be called, got %q", called)
+	}
+}
+
+func TestAs(t *testing.T) {
+	var v any = 42
+	if o := As[int](v); !o.Has(42) {
+		t.Errorf("Expected Some(42), got %v", o)
+	}
+	if o := As[string](v); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if o := As[fmt.Stringer](Val(1)); !o.IsVal() {
+		t.Errorf("Expected Option to be asserted as fmt.Stringer, got %v", o)
+	}
+}
+
+func TestFromNonZero(t *testing.T) {
+	if o := FromNonZero(0); o.IsVal() {
+		t.Errorf("Expected None for zero, got %v", o)
+	}
+	if o := FromNonZero(3); !o.Has(3) {
+		t.Errorf("Expected Some(3), got %v", o)
+	}
+	type point struct{ x, y int }
+	if o := FromNonZero(point{}); o.IsVal() {
+		t.Errorf("Expected None for zero struct, got %v", o)
+	}
+	if o := FromString(""); o.IsVal() {
+		t.Errorf("Expected None for empty string, got %v", o)
+	}
+	if o := FromString("a"); !o.Has("a") {
+		t.Errorf("Expected Some(a), got %v", o)
+	}
+}
+
+func TestOption_TakeReplace(t *testing.T) {
+	type holder struct{ token Option[string] }
+	h := holder{token: Val("abc")}
+
+	if taken := h.token.Take(); !taken.Has("abc") {
+		t.Errorf("Expected Take to return Some(abc), got %v", taken)
+	}
+	if h.token.IsVal() {
+		t.Errorf("Expected field to be None after Take, got %v", h.token)
+	}
+	if taken := h.token.Take(); taken.IsVal() {
+		t.Errorf("Expected second Take to return None, got %v", taken)
+	}
+
+	if old := h.token.Replace("x"); old.IsVal() || !h.token.Has("x") {
+		t.Errorf("Expected Replace to return None and set Some(x), got %v %v", old, h.token)
+	}
+	if old := h.token.Replace("y"); !old.Has("x") || !h.token.Has("y") {
+		t.Errorf("Expected Replace to return Some(x) and set Some(y), got %v %v", old, h.token)
+	}
+}
+
+func TestOption_GetOrInsert(t *testing.T) {
+	var o Option[int]
+	p := o.GetOrInsert(1)
+	*p += 10
+	if !o.Has(11) {
+		t.Errorf("Expected mutation through pointer to be visible, got %v", o)
+	}
+	if p := o.GetOrInsert(5); *p != 11 {
+		t.Errorf("Expected existing value to be kept, got %d", *p)
+	}
+}
+
+func TestPanicPolicy(t *testing.T) {
+	mustFail := func(int) int { return util.MustGet(0, errors.New("must fail")) }
+	if o := Map(Val(1), mustFail); o.IsVal() {
+		t.Errorf("Expected captured panic to produce None, got %v", o)
+	}
+
+	old := util.SetPanicPolicy(util.PropagatePanics)
+	defer util.SetPanicPolicy(old)
+	defer func() {
+		r := recover()
+		if err, ok := r.(error); !ok || !errors.Is(err, util.ErrMust) {
+			t.Errorf("Expected ErrMust panic to propagate, got %v", r)
+		}
+	}()
+	Map(Val(1), mustFail)
+}
+
+func TestTryMap(t *testing.T) {
+	old := util.SetPanicPolicy(util.PropagatePanics)
+	defer util.SetPanicPolicy(old)
+	o, err := TryMap(Val(1), func(int) int { panic("boom") })
+	var pe *util.PanicError
+	if o.IsVal() || !errors.As(err, &pe) || pe.Value() != "boom" {
+		t.Errorf("Expected the panic to be returned as an error, got %v, %v", o, err)
+	}
+	if o, err := TryThen(Val(1), func(v int) Option[int] { return Val(v + 1) }); !o.Has(2) || err != nil {
+		t.Errorf("Expected Val(2), got %v, %v", o, err)
+	}
+	if o, err := TryMap(Nul[int](), func(int) int { panic("unreachable") }); o.IsVal() || err != nil {
+		t.Errorf("Expected Nul without error, got %v, %v", o, err)
+	}
+}
+
+func TestOption_Expect(t *testing.T) {
+	if v := Val(1).Expect("config port"); v != 1 {
+		t.Errorf("Expected 1, got %d", v)
+	}
+	defer func() {
+		r := recover()
+		if msg, ok := r.(string); !ok || !strings.HasPrefix(msg, "config port: ") {
+			t.Errorf("Expected panic message to start with the caller message, got %v", r)
+		}
+	}()
+	Nul[int]().Expect("config port")
+}
+
+func TestOption_Format(t *testing.T) {
+	cases := []struct {
+		format string
+		opt    Option[int]
+		want   string
+	}{
+		{"%v", Val(42), "Some[int](42)"},
+		{"%s", Nul[int](), "None[int]()"},
+		{"%03d", Val(7), "Some[int](007)"},
+		{"%#v", Val(42), "option.Val[int](42)"},
+		{"%#v", Nul[int](), "option.Nul[int]()"},
+	}
+	for _, c := range cases {
+		if got := fmt.Sprintf(c.format, c.opt); got != c.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", c.format, got, c.want)
+		}
+	}
+	if got := Val[any]("x").String(); got != "Some[string](x)" {
+		t.Errorf("Expected dynamic type name for interface values, got %q", got)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	if got := Coalesce(Nul[int](), Val(2), Val(3)); !got.Has(2) {
+		t.Errorf("Expected Some(2), got %v", got)
+	}
+	if got := Coalesce[int](); got.IsVal() {
+		t.Errorf("Expected None, got %v", got)
+	}
+
+	calls := 0
+	lookup := func(o Option[string]) func() Option[string] {
+		return func() Option[string] {
+			calls++
+			return o
+		}
+	}
+	got := CoalesceFunc(lookup(Nul[string]()), lookup(Val("env")), lookup(Val("default")))
+	if !got.Has("env") || calls != 2 {
+		t.Errorf("Expected Some(env) after 2 calls, got %v after %d calls", got, calls)
+	}
+}
+
+func TestAssert(t *testing.T) {
+	positive := func(n int) bool { return n > 0 }
+	if got := Val(3).Assert(positive, "positive"); !got.Has(3) {
+		t.Errorf("Expected Some(3), got %v", got)
+	}
+	if got := Val(-1).Assert(positive, "positive"); got.IsVal() {
+		t.Errorf("Expected None, got %v", got)
+	}
+
+	old := util.SetDebugAssertions(true)
+	defer util.SetDebugAssertions(old)
+	defer func() {
+		if err, ok := recover().(*util.AssertionError); !ok || err.Msg != "positive" || err.Value != -1 {
+			t.Errorf("Expected an AssertionError panic, got %v", err)
+		}
+	}()
+	Val(-1).Assert(positive, "positive")
+}
+
+func TestFromOk(t *testing.T) {
+	m := map[string]int{"a": 1}
+	v, ok := m["a"]
+	if got := FromOk(v, ok); !got.Has(1) {
+		t.Errorf("Expected Some(1), got %v", got)
+	}
+	v, ok = m["b"]
+	if got := FromOk(v, ok); got.IsVal() {
+		t.Errorf("Expected None, got %v", got)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	var seen []string
+	Val(1).Inspect(func(v int) { seen = append(seen, fmt.Sprint("val ", v)) }).InspectNul(func() { seen = append(seen, "nul") })
+	Nul[int]().Inspect(func(int) { seen = append(seen, "unexpected") }).InspectNul(func() { seen = append(seen, "nul") })
+	if !slices.Equal(seen, []string{"val 1", "nul"}) {
+		t.Errorf("Unexpected calls: %v", seen)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Inspect not to capture panics")
+		}
+	}()
+	Val(1).Inspect(func(int) { util.MustNil(errors.New("boom")) })
+}
+
+type tags []string
+
+func (t tags) Clone() tags { return slices.Clone(t) }
+
+func TestClone(t *testing.T) {
+	o := Val(tags{"a"})
+	c := o.Clone()
+	c.Get()[0] = "b"
+	if !o.Has(tags{"a"}) {
+		t.Errorf("Expected Clone to use the Clone method, got %v", o)
+	}
+
+	s := Val([]int{1})
+	c2 := s.CloneFunc(slices.Clone)
+	c2.Get()[0] = 2
+	if !s.Has([]int{1}) {
+		t.Errorf("Expected CloneFunc to deep copy, got %v", s)
+	}
+
+	n := Val(1)
+	*n.ToPtr() = 2
+	if !n.Has(1) {
+		t.Errorf("Expected ToPtr to return a copy, got %v", n)
+	}
+}
+
+func TestThenOk(t *testing.T) {
+	m := map[string]int{"a": 1}
+	lookup := func(k string) (int, bool) { v, ok := m[k]; return v, ok }
+	if o := ThenOk(Val("a"), lookup); !o.Has(1) {
+		t.Errorf("Expected Some(1), got %v", o)
+	}
+	if o := ThenOk(Val("b"), lookup); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if o := ThenFrom(Val("12"), strconv.Atoi); !o.Has(12) {
+		t.Errorf("Expected Some(12), got %v", o)
+	}
+	if o := ThenFrom(Val("x"), strconv.Atoi); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
+
+func TestFromFuncOk(t *testing.T) {
+	m := map[string]int{"a": 1}
+	if o := FromFuncOk(func() (int, bool) { v, ok := m["a"]; return v, ok }); !o.Has(1) {
+		t.Errorf("Expected Some(1), got %v", o)
+	}
+	if o := FromFuncOk(func() (int, bool) { v, ok := m["b"]; return v, ok }); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected the must panic to propagate")
+		}
+	}()
+	FromFuncOk(func() (int, bool) { return util.MustGet(0, errors.New("fail")), true })
+}
+
+func TestToOk(t *testing.T) {
+	if v, ok := Val(3).ToOk(); v != 3 || !ok {
+		t.Errorf("Expected (3, true), got (%v, %v)", v, ok)
+	}
+	if v, ok := Nul[int]().ToOk(); v != 0 || ok {
+		t.Errorf("Expected (0, false), got (%v, %v)", v, ok)
+	}
+	if o := FromOk(Val("x").ToOk()); !o.Has("x") {
+		t.Errorf("Expected round trip, got %v", o)
+	}
+}
+
+type describeVisitor struct{}
+
+func (describeVisitor) SomeCase(v int) string { return "some " + strconv.Itoa(v) }
+func (describeVisitor) NoneCase() string      { return "none" }
+
+func TestVisit(t *testing.T) {
+	if s := Visit[int, string](Val(1), describeVisitor{}); s != "some 1" {
+		t.Errorf("Expected some 1, got %q", s)
+	}
+	if s := Visit[int, string](Nul[int](), describeVisitor{}); s != "none" {
+		t.Errorf("Expected none, got %q", s)
+	}
+}
+
+func TestWhen(t *testing.T) {
+	if o := When(true, 1); !o.Has(1) {
+		t.Errorf("Expected Some(1), got %v", o)
+	}
+	if o := When(false, 1); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if o := Unless(false, 1); !o.Has(1) {
+		t.Errorf("Expected Some(1), got %v", o)
+	}
+	called := false
+	if o := WhenFunc(false, func() int { called = true; return 1 }); o.IsVal() || called {
+		t.Errorf("Expected None without calling f, got %v", o)
+	}
+	if o := WhenFunc(true, func() int { util.MustNil(errors.New("fail")); return 1 }); o.IsVal() {
+		t.Errorf("Expected a must panic to give None, got %v", o)
+	}
+}
@@ -0,0 +1,66 @@
+package optioncmp
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	optionPkg = "github.com/viocha/go-option"
+	resultPkg = "github.com/viocha/go-option/result"
+)
+
+// Option 在比较时被转换为 Some 或 None
+type Some struct {
+	Value any
+}
+
+type None struct{}
+
+// Result 在比较时被转换为 Ok 或 Err，错误只比较消息
+type Ok struct {
+	Value any
+}
+
+type Err struct {
+	Msg string
+}
+
+// 返回比较 Option 和 Result 的 cmp.Option：cmp.Diff 输出 Some(3) 与 None 这样可读的差异，
+// 而不是因为未导出字段而 panic。Some/Ok 中的值会继续递归比较，因此嵌套的 Option 同样适用
+func Transformer() cmp.Option {
+	return cmp.Options{
+		cmp.FilterPath(isGeneric(optionPkg, "Option["), cmp.Transformer("Option", toOption)),
+		cmp.FilterPath(isGeneric(resultPkg, "Result["), cmp.Transformer("Result", toResult)),
+	}
+}
+
+// 判断路径的最后一步是否为 pkg 包中以 prefix 开头的泛型类型（不包括指针）
+func isGeneric(pkg, prefix string) func(cmp.Path) bool {
+	return func(p cmp.Path) bool {
+		t := p.Last().Type()
+		return t != nil && t.PkgPath() == pkg && strings.HasPrefix(t.Name(), prefix)
+	}
+}
+
+func toOption(v any) any {
+	rv := reflect.ValueOf(v)
+	if !call(rv, "IsVal").Bool() {
+		return None{}
+	}
+	return Some{Value: call(rv, "Get").Interface()}
+}
+
+func toResult(v any) any {
+	rv := reflect.ValueOf(v)
+	if !call(rv, "IsOk").Bool() {
+		return Err{Msg: call(rv, "GetErr").Interface().(error).Error()}
+	}
+	return Ok{Value: call(rv, "Get").Interface()}
+}
+
+func call(v reflect.Value, method string) reflect.Value {
+	return v.MethodByName(method).Call(nil)[0]
+}
@@ -0,0 +1,46 @@
+package optioncmp
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+type row struct {
+	Name  string
+	Age   opt.Option[int]
+	Email opt.Option[string]
+	Boss  opt.Option[*row]
+	Score result.Result[float64]
+}
+
+func TestTransformer(t *testing.T) {
+	a := row{Name: "a", Age: opt.Val(3), Boss: opt.Val(&row{Age: opt.Val(50), Score: result.Ok(1.0)}), Score: result.Ok(1.5)}
+	b := row{Name: "a", Age: opt.Val(3), Boss: opt.Val(&row{Age: opt.Val(50), Score: result.Ok(1.0)}), Score: result.Ok(1.5)}
+	if diff := cmp.Diff(a, b, Transformer()); diff != "" {
+		t.Errorf("Expected no diff, got:\n%s", diff)
+	}
+
+	b.Age = opt.Nul[int]()
+	b.Boss.Get().Age = opt.Val(51)
+	b.Score = result.Err[float64](errors.New("no score"))
+	diff := cmp.Diff(a, b, Transformer())
+	for _, want := range []string{"optioncmp.Some{Value: int(3)}", "optioncmp.None{}", "int(50)", "int(51)", `Msg: "no score"`} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("Expected %q in diff:\n%s", want, diff)
+		}
+	}
+}
+
+func TestTransformer_Equal(t *testing.T) {
+	if !cmp.Equal(opt.Nul[int](), opt.Nul[int](), Transformer()) {
+		t.Error("Expected two None values to be equal")
+	}
+	if cmp.Equal(result.Err[int](errors.New("a")), result.Err[int](errors.New("b")), Transformer()) {
+		t.Error("Expected errors with different messages to differ")
+	}
+}
@@ -0,0 +1,74 @@
+package option
+
+import (
+	"iter"
+	"maps"
+)
+
+// ========================== OptionMap =============================
+
+// 以 Option 形式读写的 map，零值不可用，需要通过 NewOptionMap 或 OptionMapOf 创建。不是并发安全的
+type OptionMap[K comparable, V any] struct {
+	m map[K]V
+}
+
+func NewOptionMap[K comparable, V any]() OptionMap[K, V] {
+	return OptionMap[K, V]{m: make(map[K]V)}
+}
+
+// 包装已有的 map，不会复制
+func OptionMapOf[K comparable, V any](m map[K]V) OptionMap[K, V] {
+	if m == nil {
+		m = make(map[K]V)
+	}
+	return OptionMap[K, V]{m: m}
+}
+
+// 返回键 k 对应的值，不存在时返回 Nul
+func (m OptionMap[K, V]) Get(k K) Option[V] {
+	v, ok := m.m[k]
+	return FromOk(v, ok)
+}
+
+// 设置键 k 的值，返回原来的值
+func (m OptionMap[K, V]) Set(k K, v V) Option[V] {
+	old := m.Get(k)
+	m.m[k] = v
+	return old
+}
+
+// 键 k 不存在时以 f 的返回值插入，返回键 k 对应的值
+func (m OptionMap[K, V]) GetOrInsertFunc(k K, f func() V) V {
+	if v, ok := m.m[k]; ok {
+		return v
+	}
+	v := f()
+	m.m[k] = v
+	return v
+}
+
+// 删除键 k 并返回其值，不存在时返回 Nul
+func (m OptionMap[K, V]) Pop(k K) Option[V] {
+	old := m.Get(k)
+	delete(m.m, k)
+	return old
+}
+
+func (m OptionMap[K, V]) Has(k K) bool {
+	_, ok := m.m[k]
+	return ok
+}
+
+func (m OptionMap[K, V]) Len() int {
+	return len(m.m)
+}
+
+// 以 iter.Seq2 的形式遍历所有键值对，顺序不确定
+func (m OptionMap[K, V]) All() iter.Seq2[K, V] {
+	return maps.All(m.m)
+}
+
+// 返回底层的 map
+func (m OptionMap[K, V]) Map() map[K]V {
+	return m.m
+}
@@ -0,0 +1,31 @@
+package option
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestOptionMap(t *testing.T) {
+	m := NewOptionMap[string, int]()
+	if old := m.Set("a", 1); old.IsVal() {
+		t.Errorf("Expected no previous value, got %v", old)
+	}
+	if old := m.Set("a", 2); !old.Has(1) {
+		t.Errorf("Expected previous value 1, got %v", old)
+	}
+	if v := m.GetOrInsertFunc("b", func() int { return 3 }); v != 3 || !m.Get("b").Has(3) {
+		t.Errorf("Expected b to be inserted, got %d", v)
+	}
+	if v := m.GetOrInsertFunc("b", func() int { return 4 }); v != 3 {
+		t.Errorf("Expected the existing value, got %d", v)
+	}
+	if got := maps.Collect(m.All()); len(got) != 2 || got["a"] != 2 {
+		t.Errorf("Unexpected contents: %v", got)
+	}
+	if v := m.Pop("a"); !v.Has(2) || m.Has("a") || m.Pop("a").IsVal() {
+		t.Errorf("Expected Pop to remove a, got %v", v)
+	}
+	if m.Len() != 1 || m.Get("missing").IsVal() {
+		t.Errorf("Unexpected state: %v", m.Map())
+	}
+}
@@ -0,0 +1,51 @@
+package optiontest
+
+import (
+	"testing"
+
+	option "github.com/viocha/go-option"
+	"github.com/viocha/go-option/internal/common"
+)
+
+// 断言 o 存在值
+func AssertSome[T any](t testing.TB, o option.Option[T]) bool {
+	t.Helper()
+	if o.IsNul() {
+		t.Errorf("Expected Some, got %v", o)
+		return false
+	}
+	return true
+}
+
+// 断言 o 存在值并返回该值，否则立即终止测试
+func RequireSome[T any](t testing.TB, o option.Option[T]) T {
+	t.Helper()
+	if o.IsNul() {
+		t.Fatalf("Expected Some, got %v", o)
+	}
+	return o.Get()
+}
+
+// 断言 o 不存在值
+func AssertNone[T any](t testing.TB, o option.Option[T]) bool {
+	t.Helper()
+	if o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+		return false
+	}
+	return true
+}
+
+// 断言 o 为 Val(want)，失败时输出值的差异
+func AssertHas[T any](t testing.TB, o option.Option[T], want T) bool {
+	t.Helper()
+	if o.IsNul() {
+		t.Errorf("Expected Some(%v), got %v", want, o)
+		return false
+	}
+	if !o.Has(want) {
+		t.Errorf("Unexpected Some value %s", common.Diff(want, o.Get()))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package optiontest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	option "github.com/viocha/go-option"
+)
+
+// 记录失败消息的 testing.TB
+type recorder struct {
+	testing.TB
+	msgs []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.msgs = append(r.msgs, fmt.Sprintf(format, args...))
+}
+
+func TestAssert(t *testing.T) {
+	rec := &recorder{TB: t}
+	if !AssertSome(rec, option.Val(1)) || !AssertNone(rec, option.Nul[int]()) || !AssertHas(rec, option.Val(1), 1) {
+		t.Errorf("Expected assertions to pass, got %v", rec.msgs)
+	}
+	if AssertSome(rec, option.Nul[int]()) || AssertNone(rec, option.Val(1)) {
+		t.Error("Expected assertions to fail")
+	}
+	if len(rec.msgs) != 2 {
+		t.Errorf("Expected 2 failures, got %v", rec.msgs)
+	}
+	if RequireSome(t, option.Val("a")) != "a" {
+		t.Error("Expected RequireSome to return the value")
+	}
+}
+
+func TestAssertHas_Diff(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+	rec := &recorder{TB: t}
+	AssertHas(rec, option.Val(user{"a", 1}), user{"a", 2})
+	if len(rec.msgs) != 1 || !strings.Contains(rec.msgs[0], "Age:") || strings.Contains(rec.msgs[0], "Name:") {
+		t.Errorf("Expected diff listing only Age, got %v", rec.msgs)
+	}
+}
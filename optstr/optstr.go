@@ -0,0 +1,37 @@
+package optstr
+
+import (
+	"strings"
+
+	opt "github.com/viocha/go-option"
+)
+
+// ========================== 字符串 =============================
+
+// 去除首尾空白后为空字符串时返回 Nul，否则返回去除空白后的字符串
+func TrimmedNonEmpty(s string) opt.Option[string] {
+	return opt.FromString(strings.TrimSpace(s))
+}
+
+// 存在值时返回该值，否则返回空字符串，与 o.GetOrZero() 相同
+func OrEmpty(o opt.Option[string]) string {
+	return o.GetOrZero()
+}
+
+// 使用 sep 连接所有存在的值，忽略 Nul
+func JoinSome(opts []opt.Option[string], sep string) string {
+	return strings.Join(opt.Values(opts), sep)
+}
+
+// 空切片（包括 nil）视为不存在值
+func FromBytes(b []byte) opt.Option[string] {
+	if len(b) == 0 {
+		return opt.Nul[string]()
+	}
+	return opt.Val(string(b))
+}
+
+// 将存在的值转换为 []byte
+func ToBytes(o opt.Option[string]) opt.Option[[]byte] {
+	return opt.Map(o, func(s string) []byte { return []byte(s) })
+}
@@ -0,0 +1,38 @@
+package optstr
+
+import (
+	"testing"
+
+	opt "github.com/viocha/go-option"
+)
+
+func TestTrimmedNonEmpty(t *testing.T) {
+	if o := TrimmedNonEmpty("  a b \n"); !o.Has("a b") {
+		t.Errorf("Expected Some(a b), got %v", o)
+	}
+	if o := TrimmedNonEmpty(" \t "); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
+
+func TestJoinSome(t *testing.T) {
+	parts := []opt.Option[string]{opt.Val("a"), opt.Nul[string](), opt.Val("c")}
+	if s := JoinSome(parts, ", "); s != "a, c" {
+		t.Errorf("Expected \"a, c\", got %q", s)
+	}
+	if s := OrEmpty(opt.Nul[string]()); s != "" {
+		t.Errorf("Expected an empty string, got %q", s)
+	}
+}
+
+func TestBytes(t *testing.T) {
+	if o := FromBytes(nil); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if o := FromBytes([]byte("x")); !o.Has("x") {
+		t.Errorf("Expected Some(x), got %v", o)
+	}
+	if o := ToBytes(opt.Val("ab")); !o.HasFunc(func(b []byte) bool { return string(b) == "ab" }) {
+		t.Errorf("Expected Some([a b]), got %v", o)
+	}
+}
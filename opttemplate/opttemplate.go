@@ -0,0 +1,60 @@
+package opttemplate
+
+import (
+	"reflect"
+	"strings"
+)
+
+const (
+	optionPkg = "github.com/viocha/go-option"
+	resultPkg = "github.com/viocha/go-option/result"
+)
+
+// 返回在 text/template 和 html/template 中使用 Option/Result 的函数，可以直接传给两者的 Funcs：
+//
+//	{{ getOr .Nickname "anonymous" }}  存在值（Ok）时输出该值，否则输出 fallback
+//	{{ get .Nickname }}                存在值（Ok）时输出该值，否则输出空字符串
+//	{{ if isVal .Nickname }}...{{ end }}
+//
+// 参数不是 Option/Result 时按普通值处理：get/getOr 原样返回，isVal 在参数不为 nil 时为 true。
+// Option 本身的方法同样可以在模板中直接调用，例如 {{ .Nickname.GetOr "anonymous" }}
+func FuncMap() map[string]any {
+	return map[string]any{
+		"isVal": isVal,
+		"get":   func(v any) any { return getOr(v, "") },
+		"getOr": getOr,
+	}
+}
+
+func isVal(v any) bool {
+	rv := reflect.ValueOf(v)
+	switch {
+	case isGeneric(rv, optionPkg, "Option["):
+		return call(rv, "IsVal").Bool()
+	case isGeneric(rv, resultPkg, "Result["):
+		return call(rv, "IsOk").Bool()
+	}
+	return v != nil
+}
+
+func getOr(v any, fallback any) any {
+	rv := reflect.ValueOf(v)
+	if !isGeneric(rv, optionPkg, "Option[") && !isGeneric(rv, resultPkg, "Result[") {
+		if v == nil {
+			return fallback
+		}
+		return v
+	}
+	if !isVal(v) {
+		return fallback
+	}
+	return call(rv, "Get").Interface()
+}
+
+func isGeneric(v reflect.Value, pkg, prefix string) bool {
+	return v.IsValid() && v.Type().PkgPath() == pkg && strings.HasPrefix(v.Type().Name(), prefix)
+}
+
+func call(v reflect.Value, method string) reflect.Value {
+	return v.MethodByName(method).Call(nil)[0]
+}
@@ -0,0 +1,61 @@
+package opttemplate
+
+import (
+	"bytes"
+	"errors"
+	htmltemplate "html/template"
+	"testing"
+	"text/template"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+type profile struct {
+	Name     string
+	Nickname opt.Option[string]
+	Bio      opt.Option[string]
+	Score    result.Result[int]
+}
+
+const page = `{{ .Name }}|{{ getOr .Nickname "anonymous" }}|{{ get .Bio }}|{{ getOr .Score "n/a" }}|{{ if isVal .Bio }}bio{{ else }}no bio{{ end }}|{{ .Nickname.GetOr "-" }}`
+
+func TestFuncMap(t *testing.T) {
+	p := profile{Name: "ann", Nickname: opt.Nul[string](), Bio: opt.Val("<b>hi</b>"), Score: result.Err[int](errors.New("down"))}
+
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New("t").Funcs(FuncMap()).Parse(page))
+	if err := tmpl.Execute(&buf, p); err != nil {
+		t.Fatal(err)
+	}
+	if want := "ann|anonymous|<b>hi</b>|n/a|bio|-"; buf.String() != want {
+		t.Errorf("Expected %q, got %q", want, buf.String())
+	}
+
+	buf.Reset()
+	p.Nickname, p.Bio, p.Score = opt.Val("a"), opt.Nul[string](), result.Ok(3)
+	htmpl := htmltemplate.Must(htmltemplate.New("t").Funcs(FuncMap()).Parse(page))
+	if err := htmpl.Execute(&buf, p); err != nil {
+		t.Fatal(err)
+	}
+	if want := "ann|a||3|no bio|a"; buf.String() != want {
+		t.Errorf("Expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestFuncMap_PlainValues(t *testing.T) {
+	if getOr("x", "y") != "x" || getOr(nil, "y") != "y" || !isVal(1) || isVal(nil) {
+		t.Error("Expected plain values to pass through")
+	}
+}
+
+func TestFuncMap_Escaping(t *testing.T) {
+	var buf bytes.Buffer
+	htmpl := htmltemplate.Must(htmltemplate.New("t").Funcs(FuncMap()).Parse(`{{ get . }}`))
+	if err := htmpl.Execute(&buf, opt.Val("<b>")); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "&lt;b&gt;" {
+		t.Errorf("Expected the value to be escaped, got %q", buf.String())
+	}
+}
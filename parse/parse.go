@@ -0,0 +1,120 @@
+package parse
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/internal/common"
+	"github.com/viocha/go-option/result"
+)
+
+var ErrZeroTime = errors.New("zero time") // 解析得到的时间为零时间
+
+// ========================== 基础类型 =============================
+
+func Int(s string) result.Result[int] {
+	return result.From(strconv.Atoi(s))
+}
+
+func Int64(s string) result.Result[int64] {
+	return result.From(strconv.ParseInt(s, 10, 64))
+}
+
+func Uint64(s string) result.Result[uint64] {
+	return result.From(strconv.ParseUint(s, 10, 64))
+}
+
+func Float(s string) result.Result[float64] {
+	return result.From(strconv.ParseFloat(s, 64))
+}
+
+// 接受 strconv.ParseBool 支持的所有形式
+func Bool(s string) result.Result[bool] {
+	return result.From(strconv.ParseBool(s))
+}
+
+func Duration(s string) result.Result[time.Duration] {
+	return result.From(time.ParseDuration(s))
+}
+
+// 按 layout 解析时间，零时间常被用作“未设置”的标记，因此解析结果为零时间时返回 ErrZeroTime
+func Time(layout, s string) result.Result[time.Time] {
+	return nonZero(result.From(time.Parse(layout, s)))
+}
+
+// 与 Time 相同，但没有时区信息的时间按 loc 解释
+func TimeIn(layout, s string, loc *time.Location) result.Result[time.Time] {
+	return nonZero(result.From(time.ParseInLocation(layout, s, loc)))
+}
+
+// 解析 RFC 3339 格式的时间，与 Time 一样，解析结果为零时间时返回 ErrZeroTime
+func RFC3339(s string) result.Result[time.Time] {
+	return Time(time.RFC3339, s)
+}
+
+func nonZero(r result.Result[time.Time]) result.Result[time.Time] {
+	return result.Then(r, func(t time.Time) result.Result[time.Time] {
+		if t.IsZero() {
+			return result.Err[time.Time](ErrZeroTime)
+		}
+		return result.Ok(t)
+	})
+}
+
+func URL(s string) result.Result[*url.URL] {
+	return result.From(url.Parse(s))
+}
+
+func IntOpt(s string) opt.Option[int]                { return Int(s).Val() }
+func Int64Opt(s string) opt.Option[int64]            { return Int64(s).Val() }
+func Uint64Opt(s string) opt.Option[uint64]          { return Uint64(s).Val() }
+func FloatOpt(s string) opt.Option[float64]          { return Float(s).Val() }
+func BoolOpt(s string) opt.Option[bool]              { return Bool(s).Val() }
+func DurationOpt(s string) opt.Option[time.Duration] { return Duration(s).Val() }
+func TimeOpt(layout, s string) opt.Option[time.Time] { return Time(layout, s).Val() }
+func RFC3339Opt(s string) opt.Option[time.Time]      { return RFC3339(s).Val() }
+func TimeInOpt(layout, s string, loc *time.Location) opt.Option[time.Time] {
+	return TimeIn(layout, s, loc).Val()
+}
+func URLOpt(s string) opt.Option[*url.URL] { return URL(s).Val() }
+
+// ========================== 自定义类型 =============================
+
+var parsers sync.Map // reflect.Type -> func(string) (any, error)
+
+// 注册将字符串解析为 T 的函数，例如 uuid.Parse，之后可以通过 As[T] 使用
+func Register[T any](parse func(string) (T, error)) {
+	parsers.Store(reflect.TypeFor[T](), func(s string) (any, error) {
+		return parse(s)
+	})
+}
+
+// 将 s 解析为 T：优先使用 Register 注册的函数，其次是 encoding.TextUnmarshaler、time.Duration 和基础类型
+func As[T any](s string) result.Result[T] {
+	v, err := Value(s, reflect.TypeFor[T]())
+	if err != nil {
+		return result.Err[T](err)
+	}
+	return result.Ok(v.Interface().(T))
+}
+
+func AsOpt[T any](s string) opt.Option[T] {
+	return As[T](s).Val()
+}
+
+// As 的反射版本
+func Value(s string, typ reflect.Type) (reflect.Value, error) {
+	if p, ok := parsers.Load(typ); ok {
+		v, err := p.(func(string) (any, error))(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(v), nil
+	}
+	return common.ParseString(s, typ)
+}
@@ -0,0 +1,72 @@
+package parse
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBasic(t *testing.T) {
+	if r := Int("42"); !r.Has(42) {
+		t.Errorf("Expected Ok(42), got %v", r)
+	}
+	if r := Int("x"); !r.HasErr(strconv.ErrSyntax) {
+		t.Errorf("Expected ErrSyntax, got %v", r)
+	}
+	if o := FloatOpt("1.5"); !o.Has(1.5) {
+		t.Errorf("Expected Some(1.5), got %v", o)
+	}
+	if o := BoolOpt("maybe"); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if r := Time(time.DateOnly, "2024-01-02"); !r.HasFunc(func(tm time.Time) bool { return tm.Day() == 2 }) {
+		t.Errorf("Expected a parsed date, got %v", r)
+	}
+	if o := URLOpt("https://example.com/a"); !o.HasFunc(func(u *url.URL) bool { return u.Host == "example.com" }) {
+		t.Errorf("Expected a parsed URL, got %v", o)
+	}
+}
+
+type id [2]string
+
+func TestRegister(t *testing.T) {
+	errFormat := errors.New("bad id")
+	Register(func(s string) (id, error) {
+		a, b, ok := strings.Cut(s, "-")
+		if !ok {
+			return id{}, errFormat
+		}
+		return id{a, b}, nil
+	})
+	if r := As[id]("a-b"); !r.Has(id{"a", "b"}) {
+		t.Errorf("Expected Ok(id), got %v", r)
+	}
+	if r := As[id]("ab"); !r.HasErr(errFormat) {
+		t.Errorf("Expected errFormat, got %v", r)
+	}
+	if o := AsOpt[time.Duration]("3s"); !o.Has(3 * time.Second) {
+		t.Errorf("Expected Some(3s), got %v", o)
+	}
+}
+
+func TestTime(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	if r := TimeIn(time.DateTime, "2024-01-02 03:04:05", loc); !r.HasFunc(func(tm time.Time) bool { return tm.Location() == loc }) {
+		t.Errorf("Expected a time in loc, got %v", r)
+	}
+	if o := RFC3339Opt("2024-01-02T03:04:05Z"); !o.HasFunc(func(tm time.Time) bool { return tm.Year() == 2024 }) {
+		t.Errorf("Expected a parsed time, got %v", o)
+	}
+	if r := RFC3339("0001-01-01T00:00:00Z"); !r.HasErr(ErrZeroTime) {
+		t.Errorf("Expected ErrZeroTime, got %v", r)
+	}
+	if r := Time(time.DateTime, "0001-01-01 00:00:00"); !r.HasErr(ErrZeroTime) {
+		t.Errorf("Expected ErrZeroTime from Time, got %v", r)
+	}
+	if r := TimeIn(time.DateTime, "0001-01-01 00:00:00", time.UTC); !r.HasErr(ErrZeroTime) {
+		t.Errorf("Expected ErrZeroTime from TimeIn, got %v", r)
+	}
+}
@@ -0,0 +1,37 @@
+package parse
+
+import (
+	"net/url"
+	"time"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+// ========================== 查询参数 =============================
+
+// 读取并解析查询参数或表单字段：不存在或为空字符串时返回 Ok(Nul)，
+// 无法解析时返回 Err，错误消息中包含参数名，从而区分“未提供”和“格式错误”
+func Query[T any](v url.Values, key string) result.Result[opt.Option[T]] {
+	return query(v, key, As[T])
+}
+
+func QueryInt(v url.Values, key string) result.Result[opt.Option[int]] {
+	return query(v, key, Int)
+}
+
+func QueryBool(v url.Values, key string) result.Result[opt.Option[bool]] {
+	return query(v, key, Bool)
+}
+
+func QueryTime(v url.Values, key, layout string) result.Result[opt.Option[time.Time]] {
+	return query(v, key, func(s string) result.Result[time.Time] { return Time(layout, s) })
+}
+
+func query[T any](v url.Values, key string, parse func(string) result.Result[T]) result.Result[opt.Option[T]] {
+	s := opt.FromURLValues(v, key).Filter(func(s string) bool { return s != "" })
+	if s.IsNul() {
+		return result.Ok(opt.Nul[T]())
+	}
+	return result.Map(parse(s.Get()).Wrapf("invalid %s", key), opt.Val[T])
+}
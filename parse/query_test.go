@@ -0,0 +1,36 @@
+package parse
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	opt "github.com/viocha/go-option"
+)
+
+func TestQuery(t *testing.T) {
+	v := url.Values{"page": {"2"}, "size": {"x"}, "debug": {"true"}, "since": {"2024-01-02"}, "q": {""}}
+	if r := QueryInt(v, "page"); !r.HasFunc(func(o opt.Option[int]) bool { return o.Has(2) }) {
+		t.Errorf("Expected Ok(Some(2)), got %v", r)
+	}
+	if r := QueryInt(v, "limit"); !r.HasFunc(func(o opt.Option[int]) bool { return o.IsNul() }) {
+		t.Errorf("Expected Ok(None) for a missing key, got %v", r)
+	}
+	if r := QueryInt(v, "q"); !r.HasFunc(func(o opt.Option[int]) bool { return o.IsNul() }) {
+		t.Errorf("Expected Ok(None) for an empty value, got %v", r)
+	}
+	if r := QueryInt(v, "size"); !r.HasErr(strconv.ErrSyntax) || !strings.HasPrefix(r.GetErr().Error(), "invalid size") {
+		t.Errorf("Expected a syntax error naming the key, got %v", r)
+	}
+	if r := QueryBool(v, "debug"); !r.IsOk() || !r.Get().Has(true) {
+		t.Errorf("Expected Ok(Some(true)), got %v", r)
+	}
+	if r := QueryTime(v, "since", time.DateOnly); !r.IsOk() || r.Get().Get().Day() != 2 {
+		t.Errorf("Expected a parsed date, got %v", r)
+	}
+	if r := Query[time.Duration](url.Values{"ttl": {"5s"}}, "ttl"); !r.IsOk() || !r.Get().Has(5*time.Second) {
+		t.Errorf("Expected Ok(Some(5s)), got %v", r)
+	}
+}
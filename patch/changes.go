@@ -0,0 +1,66 @@
+package patch
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/viocha/go-option/result"
+)
+
+// ========================== 由请求结构体生成补丁 =============================
+
+// 比较 update 与 current（结构体或结构体指针，可以是不同的类型），返回 update 中会改变 current 的字段，
+// 键为字段的 JSON 标签名（没有标签时为字段名）。update 中的 Option 字段为 Nul 时视为未提供，不产生变更；
+// 其他字段总是视为已提供。带有 `patch:"-"` 标签的字段被忽略，current 中不存在的字段返回 ErrUnknownField
+func Changes(current, update any) result.Result[map[string]any] {
+	cv := reflect.Indirect(reflect.ValueOf(current))
+	uv := reflect.Indirect(reflect.ValueOf(update))
+	if cv.Kind() != reflect.Struct || uv.Kind() != reflect.Struct {
+		return result.Err[map[string]any](fmt.Errorf("Changes: expected structs, got %T and %T", current, update))
+	}
+	currentFields := fieldsByName(cv)
+	changes := make(map[string]any)
+	for i := range uv.NumField() {
+		f := uv.Type().Field(i)
+		name, ok := jsonName(f)
+		if !ok || !f.IsExported() || f.Tag.Get("patch") == "-" {
+			continue
+		}
+		val, provided := optionValue(uv.Field(i))
+		if !provided {
+			continue
+		}
+		old, ok := currentFields[name]
+		if !ok {
+			return result.Err[map[string]any](fmt.Errorf("%w: %s", ErrUnknownField, name))
+		}
+		if oldVal, ok := optionValue(old); !ok || !reflect.DeepEqual(oldVal, val) {
+			changes[name] = val
+		}
+	}
+	return result.Ok(changes)
+}
+
+// 将 update 中的变更（参见 Changes）应用到 dst 指向的结构体，返回实际应用的变更
+func Apply(dst, update any) result.Result[map[string]any] {
+	return result.Then(Changes(dst, update), func(changes map[string]any) result.Result[map[string]any] {
+		patches := make(map[string]Patch[any], len(changes))
+		for name, v := range changes {
+			patches[name] = Set(v)
+		}
+		return result.Map(ApplyPatch(dst, patches), func(struct{}) map[string]any { return changes })
+	})
+}
+
+// 返回字段中的值以及是否存在值：Option 字段按 IsVal/Get 读取，其他字段总是存在值
+func optionValue(field reflect.Value) (any, bool) {
+	isVal := field.MethodByName("IsVal")
+	get := field.MethodByName("Get")
+	if !isVal.IsValid() || !get.IsValid() {
+		return field.Interface(), true
+	}
+	if !isVal.Call(nil)[0].Bool() {
+		return nil, false
+	}
+	return get.Call(nil)[0].Interface(), true
+}
@@ -0,0 +1,185 @@
+package patch
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/viocha/go-option/internal/common"
+	"github.com/viocha/go-option/parse"
+	"github.com/viocha/go-option/result"
+)
+
+var (
+	ErrTarget       = errors.New("patch target must be a non-nil pointer to struct")
+	ErrUnknownField = errors.New("unknown patch field")
+)
+
+type state int
+
+const (
+	keep state = iota
+	clear
+	set
+)
+
+// 三态的补丁值：保持不变、清空、设置为新值
+type Patch[T any] struct {
+	state state
+	val   T
+}
+
+// 保持字段不变
+func Keep[T any]() Patch[T] {
+	return Patch[T]{state: keep}
+}
+
+// 将字段清空为零值（Option 字段为 Nul）
+func Clear[T any]() Patch[T] {
+	return Patch[T]{state: clear}
+}
+
+// 将字段设置为 v
+func Set[T any](v T) Patch[T] {
+	return Patch[T]{state: set, val: v}
+}
+
+func (p Patch[T]) IsKeep() bool  { return p.state == keep }
+func (p Patch[T]) IsClear() bool { return p.state == clear }
+func (p Patch[T]) IsSet() bool   { return p.state == set }
+
+// 返回补丁中的值，仅在 IsSet 时有意义
+func (p Patch[T]) Get() T {
+	return p.val
+}
+
+func (p Patch[T]) String() string {
+	switch p.state {
+	case clear:
+		return "Clear"
+	case set:
+		return fmt.Sprintf("Set(%v)", p.val)
+	}
+	return "Keep"
+}
+
+// ========================== 解析器注册表 =============================
+
+// 注册将字符串解析为 T 的函数，ApplyPatch 在字符串无法直接赋值给字段时使用。与 parse.Register 共用同一个注册表
+func RegisterParser[T any](fn func(string) (T, error)) {
+	parse.Register(fn)
+}
+
+// ========================== 应用补丁 =============================
+
+// 将 patches 应用到 dst 指向的结构体，键为字段的 JSON 标签名（没有标签时为字段名）。
+// Option 字段（以及其他实现了 sql.Scanner 的字段）通过 Scan 设置，Clear 会将其设置为 Nul
+func ApplyPatch(dst any, patches map[string]Patch[any]) result.Result[struct{}] {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return result.Err[struct{}](ErrTarget)
+	}
+	fields := fieldsByName(rv.Elem())
+	var errs []error
+	for name, p := range patches {
+		field, ok := fields[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrUnknownField, name))
+			continue
+		}
+		if err := apply(field, p); err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %w", name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return result.Err[struct{}](errors.Join(errs...))
+	}
+	return result.Ok(struct{}{})
+}
+
+func apply(field reflect.Value, p Patch[any]) error {
+	if p.IsKeep() {
+		return nil
+	}
+	scanner, isScanner := field.Addr().Interface().(sql.Scanner)
+	if p.IsClear() {
+		if isScanner {
+			return scanner.Scan(nil)
+		}
+		field.SetZero()
+		return nil
+	}
+	if isScanner {
+		val := p.val
+		if s, ok := val.(string); ok {
+			if typ, ok := scanTargetType(field); ok && typ.Kind() != reflect.String {
+				v, err := parse.Value(s, typ)
+				if err != nil {
+					return err
+				}
+				val = v.Interface()
+			}
+		}
+		return scanner.Scan(val)
+	}
+	v, err := convert(p.val, field.Type())
+	if err != nil {
+		return err
+	}
+	field.Set(v)
+	return nil
+}
+
+// 将任意值转换为 typ 类型，字符串按 parse.Value 解析，其余值只进行无损转换（参见 common.ConvertValue）
+func convert(val any, typ reflect.Type) (reflect.Value, error) {
+	rv := reflect.ValueOf(val)
+	switch {
+	case !rv.IsValid():
+		return reflect.Zero(typ), nil
+	case rv.Type().AssignableTo(typ):
+		return rv, nil
+	case rv.Kind() == reflect.String && typ.Kind() != reflect.String && typ.Kind() != reflect.Slice:
+		return parse.Value(rv.String(), typ)
+	}
+	return common.ConvertValue(rv, typ)
+}
+
+// Option[T] 字段中值的类型，通过其 Get 方法的返回值得到
+func scanTargetType(field reflect.Value) (reflect.Type, bool) {
+	m, ok := field.Type().MethodByName("Get")
+	if !ok || m.Type.NumOut() != 1 {
+		return nil, false
+	}
+	return m.Type.Out(0), true
+}
+
+func fieldsByName(v reflect.Value) map[string]reflect.Value {
+	fields := make(map[string]reflect.Value)
+	for i := range v.NumField() {
+		f := v.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		if name, ok := jsonName(f); ok {
+			fields[name] = v.Field(i)
+		}
+	}
+	return fields
+}
+
+// 字段的 JSON 标签名（没有标签时为字段名），标签为 "-" 时返回 false
+func jsonName(f reflect.StructField) (string, bool) {
+	name := f.Name
+	if tag, ok := f.Tag.Lookup("json"); ok {
+		tagName, _, _ := strings.Cut(tag, ",")
+		if tagName == "-" {
+			return "", false
+		}
+		if tagName != "" {
+			name = tagName
+		}
+	}
+	return name, true
+}
@@ -0,0 +1,110 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	opt "github.com/viocha/go-option"
+)
+
+type profile struct {
+	Name     string             `json:"name"`
+	Age      int                `json:"age"`
+	Nickname opt.Option[string] `json:"nickname"`
+	Score    opt.Option[int]    `json:"score,omitempty"`
+	Timeout  time.Duration      `json:"timeout"`
+	Secret   string             `json:"-"`
+}
+
+func TestApplyPatch(t *testing.T) {
+	p := profile{Name: "a", Age: 1, Nickname: opt.Val("nick"), Score: opt.Val(3)}
+	r := ApplyPatch(&p, map[string]Patch[any]{
+		"name":     Set[any]("b"),
+		"age":      Set[any]("42"),
+		"nickname": Clear[any](),
+		"score":    Set[any]("7"),
+		"timeout":  Keep[any](),
+	})
+	if !r.IsOk() {
+		t.Fatalf("Expected Ok, got %v", r)
+	}
+	if p.Name != "b" || p.Age != 42 || p.Nickname.IsVal() || !p.Score.Has(7) {
+		t.Errorf("Unexpected patched value: %+v", p)
+	}
+}
+
+func TestApplyPatch_Registry(t *testing.T) {
+	RegisterParser(time.ParseDuration)
+	var p profile
+	if r := ApplyPatch(&p, map[string]Patch[any]{"timeout": Set[any]("1m")}); !r.IsOk() || p.Timeout != time.Minute {
+		t.Errorf("Expected registered parser to be used, got %v %v", r, p.Timeout)
+	}
+}
+
+func TestApplyPatch_Errors(t *testing.T) {
+	var p profile
+	if r := ApplyPatch(p, nil); !r.HasErr(ErrTarget) {
+		t.Errorf("Expected ErrTarget, got %v", r)
+	}
+	r := ApplyPatch(&p, map[string]Patch[any]{
+		"Secret": Set[any]("x"),
+		"age":    Set[any]("abc"),
+	})
+	if !r.HasErr(ErrUnknownField) {
+		t.Errorf("Expected ErrUnknownField for ignored field, got %v", r)
+	}
+	if !r.HasErrFunc(func(e error) bool { return strings.Contains(e.Error(), "field age") }) {
+		t.Errorf("Expected a parse error for field age, got %v", r)
+	}
+}
+
+type profileUpdate struct {
+	Name     opt.Option[string] `json:"name"`
+	Age      opt.Option[int]    `json:"age"`
+	Nickname opt.Option[string] `json:"nickname"`
+	Score    opt.Option[int]    `json:"score" patch:"-"`
+}
+
+func TestChanges(t *testing.T) {
+	p := profile{Name: "a", Age: 1, Nickname: opt.Val("nick")}
+	r := Changes(p, profileUpdate{Name: opt.Val("a"), Age: opt.Val(2), Nickname: opt.Val("new"), Score: opt.Val(9)})
+	if !r.IsOk() {
+		t.Fatalf("Expected Ok, got %v", r)
+	}
+	if c := r.Get(); len(c) != 2 || c["age"] != 2 || c["nickname"] != "new" {
+		t.Errorf("Unexpected changes: %v", c)
+	}
+	if c := Changes(p, profileUpdate{}).Get(); len(c) != 0 {
+		t.Errorf("Expected no changes for empty update, got %v", c)
+	}
+	if r := Changes(p, struct{ Missing opt.Option[int] }{opt.Val(1)}); !r.HasErr(ErrUnknownField) {
+		t.Errorf("Expected ErrUnknownField, got %v", r)
+	}
+}
+
+func TestApply(t *testing.T) {
+	p := profile{Name: "a", Age: 1}
+	r := Apply(&p, profileUpdate{Age: opt.Val(5), Nickname: opt.Val("nick")})
+	if !r.IsOk() || len(r.Get()) != 2 {
+		t.Fatalf("Expected 2 changes, got %v", r)
+	}
+	if p.Name != "a" || p.Age != 5 || !p.Nickname.Has("nick") {
+		t.Errorf("Unexpected patched value: %+v", p)
+	}
+	if r := Apply(p, profileUpdate{Age: opt.Val(6)}); !r.HasErr(ErrTarget) {
+		t.Errorf("Expected ErrTarget, got %v", r)
+	}
+}
+
+func TestApplyPatch_Lossless(t *testing.T) {
+	var p profile
+	for name, v := range map[string]any{"name": 65, "age": 2.5, "score": uint64(1) << 63} {
+		if r := ApplyPatch(&p, map[string]Patch[any]{name: Set(v)}); r.IsOk() {
+			t.Errorf("Expected %s=%v to be rejected, got %+v", name, v, p)
+		}
+	}
+	if r := ApplyPatch(&p, map[string]Patch[any]{"age": Set[any](int64(7))}); !r.IsOk() || p.Age != 7 {
+		t.Errorf("Expected age 7, got %v (%v)", p.Age, r)
+	}
+}
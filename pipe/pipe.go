@@ -0,0 +1,101 @@
+package pipe
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/viocha/go-option/result"
+)
+
+var ErrTypeMismatch = errors.New("pipe step type mismatch")
+
+// 流水线中的一步，通过 Bind、Map、Try 构造，输入和输出类型在构造时记录并在执行时检查
+type Step struct {
+	in, out reflect.Type
+	run     func(any) result.Result[any]
+}
+
+// 使用返回 Result 的函数构造一步
+func Bind[T, U any](f func(T) result.Result[U]) Step {
+	return Step{
+		in:  reflect.TypeFor[T](),
+		out: reflect.TypeFor[U](),
+		run: func(v any) result.Result[any] {
+			// T 为接口类型时上一步可能产生 nil，此时使用零值
+			tv, _ := v.(T)
+			return result.Map(result.Then(result.Ok(tv), f), func(u U) any { return u })
+		},
+	}
+}
+
+// 使用普通的转换函数构造一步
+func Map[T, U any](f func(T) U) Step {
+	return Bind(func(v T) result.Result[U] { return result.FromFunc(func() U { return f(v) }) })
+}
+
+// 使用返回 (U, error) 的函数构造一步
+func Try[T, U any](f func(T) (U, error)) Step {
+	return Bind(func(v T) result.Result[U] { return result.From(f(v)) })
+}
+
+// 异构的流水线，内部以 any 保存当前的值，并记录其静态类型
+type Pipe struct {
+	typ reflect.Type
+	r   result.Result[any]
+}
+
+// 以 r 开始一条流水线
+func Start[T any](r result.Result[T]) Pipe {
+	return Pipe{typ: reflect.TypeFor[T](), r: result.Map(r, func(v T) any { return v })}
+}
+
+// 当前为 Ok 时执行 s，当前值的类型不能赋值给 s 的输入类型时返回 ErrTypeMismatch
+func (p Pipe) Then(s Step) Pipe {
+	next := Pipe{typ: s.out, r: p.r}
+	if p.r.IsErr() {
+		return next
+	}
+	if !p.typ.AssignableTo(s.in) {
+		next.r = result.Err[any](fmt.Errorf("%w: step expects %v, got %v", ErrTypeMismatch, s.in, p.typ))
+		return next
+	}
+	next.r = result.Then(p.r, s.run)
+	return next
+}
+
+// 与 Then 相同，但 s 返回的错误会被包装为 result.StepError，可以通过 FailedStep 获取失败的步骤名
+func (p Pipe) Step(name string, s Step) Pipe {
+	run := s.run
+	s.run = func(v any) result.Result[any] {
+		return result.ThenStep(result.Ok(v), name, run)
+	}
+	return p.Then(s)
+}
+
+// 依次执行所有步骤
+func (p Pipe) Do(steps ...Step) Pipe {
+	for _, s := range steps {
+		p = p.Then(s)
+	}
+	return p
+}
+
+// 以 Result[any] 的形式返回当前结果
+func (p Pipe) Result() result.Result[any] {
+	return p.r
+}
+
+// 以 Result[T] 的形式返回流水线的结果，最后一步的输出类型不能赋值给 T 时返回 ErrTypeMismatch
+func Get[T any](p Pipe) result.Result[T] {
+	if p.r.IsErr() {
+		return result.Propagate[T](p.r.GetErr())
+	}
+	if want := reflect.TypeFor[T](); !p.typ.AssignableTo(want) {
+		return result.Err[T](fmt.Errorf("%w: pipe produces %v, got %v", ErrTypeMismatch, p.typ, want))
+	}
+	return result.Map(p.r, func(v any) T {
+		tv, _ := v.(T)
+		return tv
+	})
+}
@@ -0,0 +1,76 @@
+package pipe
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+type user struct {
+	ID   int
+	Name string
+}
+
+func TestPipe(t *testing.T) {
+	p := Start(result.Ok(" 42 ")).
+		Then(Map(strings.TrimSpace)).
+		Then(Try(strconv.Atoi)).
+		Then(Bind(func(id int) result.Result[user] { return result.Ok(user{ID: id, Name: "ann"}) })).
+		Then(Map(func(u user) string { return u.Name + "#" + strconv.Itoa(u.ID) }))
+	if r := Get[string](p); !r.Has("ann#42") {
+		t.Errorf("Expected Ok(ann#42), got %v", r)
+	}
+	if r := p.Result(); !r.Has("ann#42") {
+		t.Errorf("Expected Ok(ann#42), got %v", r)
+	}
+}
+
+func TestPipe_Err(t *testing.T) {
+	calls := 0
+	p := Start(result.Ok("x")).Do(
+		Try(strconv.Atoi),
+		Map(func(n int) int { calls++; return n }),
+	)
+	if r := Get[int](p); !errors.Is(r.GetErr(), strconv.ErrSyntax) || calls != 0 {
+		t.Errorf("Expected the parse error to short-circuit, got %v after %d calls", r, calls)
+	}
+}
+
+func TestPipe_TypeMismatch(t *testing.T) {
+	p := Start(result.Ok(1)).Then(Map(strings.TrimSpace))
+	if r := Get[string](p); !errors.Is(r.GetErr(), ErrTypeMismatch) {
+		t.Errorf("Expected ErrTypeMismatch for a mismatched step, got %v", r)
+	}
+	if r := Get[int](Start(result.Ok("s"))); !errors.Is(r.GetErr(), ErrTypeMismatch) {
+		t.Errorf("Expected ErrTypeMismatch for a mismatched Get, got %v", r)
+	}
+}
+
+func TestPipe_Interface(t *testing.T) {
+	p := Start(result.Ok(errors.New("boom"))).Then(Map(func(err error) string { return err.Error() }))
+	if r := Get[any](p); !r.Has("boom") {
+		t.Errorf("Expected assignable types to be accepted, got %v", r)
+	}
+}
+
+func TestPipe_NilInterface(t *testing.T) {
+	p := Start(result.Ok[error](nil)).Then(Map(func(err error) bool { return err == nil }))
+	if r := Get[bool](p); !r.Has(true) {
+		t.Errorf("Expected a nil error to reach the step, got %v", r)
+	}
+	if r := Get[error](Start(result.Ok[error](nil))); !r.IsOk() || r.Get() != nil {
+		t.Errorf("Expected Ok(nil), got %v", r)
+	}
+}
+
+func TestPipe_Step(t *testing.T) {
+	p := Start(result.Ok("x")).
+		Step("trim", Map(strings.TrimSpace)).
+		Step("parse", Try(strconv.Atoi))
+	if r := Get[int](p); !r.FailedStep().Has("parse") || !r.HasErr(strconv.ErrSyntax) {
+		t.Errorf("Expected the parse step to fail, got %v", r)
+	}
+}
@@ -0,0 +1,187 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/viocha/go-option/result"
+)
+
+// 流水线中的一个阶段：从 in 中接收结果，将处理后的结果发送到返回的 channel，in 关闭或 ctx 被取消后关闭返回的 channel
+type Stage[T, U any] func(ctx context.Context, in <-chan result.Result[T]) <-chan result.Result[U]
+
+// ========================== 构造阶段 =============================
+
+type stageConfig struct {
+	workers int
+	buffer  int
+}
+
+type StageOption func(*stageConfig)
+
+// 并发执行的 worker 数量，默认为 1。多于 1 个 worker 时输出的顺序不再与输入一致
+func Workers(n int) StageOption {
+	return func(c *stageConfig) { c.workers = max(n, 1) }
+}
+
+// 输出 channel 的缓冲区大小，默认无缓冲
+func Buffer(n int) StageOption {
+	return func(c *stageConfig) { c.buffer = max(n, 0) }
+}
+
+// 对每个 Ok 的值调用 f，Err 原样向后传递。f 中的 ErrMust panic 会被转换为 Err
+func Map[T, U any](f func(context.Context, T) result.Result[U], opts ...StageOption) Stage[T, U] {
+	cfg := stageConfig{workers: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return func(ctx context.Context, in <-chan result.Result[T]) <-chan result.Result[U] {
+		out := make(chan result.Result[U], cfg.buffer)
+		var wg sync.WaitGroup
+		for range cfg.workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					var r result.Result[T]
+					var ok bool
+					select {
+					case r, ok = <-in:
+						if !ok {
+							return
+						}
+					case <-ctx.Done():
+						return
+					}
+					res := result.Then(r, func(v T) result.Result[U] { return f(ctx, v) })
+					if !send(ctx, out, res) {
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(out)
+		}()
+		return out
+	}
+}
+
+// 只保留满足 pred 的 Ok 值，Err 原样向后传递
+func Filter[T any](pred func(T) bool, opts ...StageOption) Stage[T, T] {
+	return FlatMap(func(_ context.Context, v T) []result.Result[T] {
+		if pred(v) {
+			return []result.Result[T]{result.Ok(v)}
+		}
+		return nil
+	}, opts...)
+}
+
+// 对每个 Ok 的值调用 f，依次发送 f 返回的所有结果，Err 原样向后传递
+func FlatMap[T, U any](f func(context.Context, T) []result.Result[U], opts ...StageOption) Stage[T, U] {
+	cfg := stageConfig{workers: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return func(ctx context.Context, in <-chan result.Result[T]) <-chan result.Result[U] {
+		batches := Map(func(ctx context.Context, v T) result.Result[[]result.Result[U]] {
+			return result.Ok(f(ctx, v))
+		}, opts...)(ctx, in)
+		out := make(chan result.Result[U], cfg.buffer)
+		go func() {
+			defer close(out)
+			for batch := range batches {
+				if batch.IsErr() {
+					if !send(ctx, out, result.Propagate[U](batch.GetErr())) {
+						return
+					}
+					continue
+				}
+				for _, r := range batch.Get() {
+					if !send(ctx, out, r) {
+						return
+					}
+				}
+			}
+		}()
+		return out
+	}
+}
+
+// 将两个阶段串联为一个阶段
+func Chain[T, U, V any](a Stage[T, U], b Stage[U, V]) Stage[T, V] {
+	return func(ctx context.Context, in <-chan result.Result[T]) <-chan result.Result[V] {
+		return b(ctx, a(ctx, in))
+	}
+}
+
+// ========================== 执行 =============================
+
+// 将 items 依次作为 Ok 发送到返回的 channel，全部发送或 ctx 被取消后关闭
+func Source[T any](ctx context.Context, items []T) <-chan result.Result[T] {
+	out := make(chan result.Result[T])
+	go func() {
+		defer close(out)
+		for _, v := range items {
+			if !send(ctx, out, result.Ok(v)) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+type runConfig struct {
+	collectAll bool
+}
+
+type RunOption func(*runConfig)
+
+// 某个结果为 Err 时继续处理其余元素，最终返回合并后的错误（参见 errors.Join）
+func CollectAllErrs() RunOption {
+	return func(c *runConfig) { c.collectAll = true }
+}
+
+// 以 items 为输入执行 stage，全部为 Ok 时返回所有值（顺序参见 Workers）。
+// 默认在第一个 Err 出现后取消所有阶段并返回该错误，返回前所有阶段的 goroutine 都会收到取消信号
+func Run[T, U any](ctx context.Context, items []T, stage Stage[T, U], opts ...RunOption) result.Result[[]U] {
+	var cfg runConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var vals []U
+	var errs []error
+	for r := range stage(ctx, Source(ctx, items)) {
+		if r.IsOk() {
+			vals = append(vals, r.Get())
+			continue
+		}
+		if !cfg.collectAll {
+			return result.Propagate[[]U](r.GetErr())
+		}
+		errs = append(errs, r.GetErr())
+	}
+	// 被取消的阶段会提前关闭 channel，此时收到的值并不完整
+	if ctx.Err() != nil {
+		errs = append(errs, context.Cause(ctx))
+	}
+	if len(errs) > 0 {
+		return result.Propagate[[]U](errors.Join(errs...))
+	}
+	return result.Ok(vals)
+}
+
+// 发送 r，ctx 被取消时放弃发送并返回 false
+func send[T any](ctx context.Context, ch chan<- result.Result[T], r result.Result[T]) bool {
+	select {
+	case ch <- r:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
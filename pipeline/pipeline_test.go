@@ -0,0 +1,97 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestRun(t *testing.T) {
+	double := Map(func(_ context.Context, v int) result.Result[int] { return result.Ok(v * 2) })
+	format := Map(func(_ context.Context, v int) result.Result[string] { return result.Ok(strconv.Itoa(v)) })
+	even := Filter(func(v int) bool { return v%4 == 0 })
+
+	r := Run(context.Background(), []int{1, 2, 3, 4}, Chain(Chain(double, even), format))
+	if !r.IsOk() || !slices.Equal(r.Get(), []string{"4", "8"}) {
+		t.Errorf("Expected Ok([4 8]), got %v", r)
+	}
+}
+
+func TestRun_Workers(t *testing.T) {
+	var running, peak atomic.Int32
+	stage := Map(func(_ context.Context, v int) result.Result[int] {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		return result.Ok(v)
+	}, Workers(3))
+
+	r := Run(context.Background(), make([]int, 12), stage)
+	if !r.IsOk() || len(r.Get()) != 12 {
+		t.Fatalf("Expected 12 values, got %v", r)
+	}
+	if p := peak.Load(); p > 3 || p < 2 {
+		t.Errorf("Expected at most 3 concurrent calls, got %d", p)
+	}
+}
+
+func TestRun_FailFast(t *testing.T) {
+	errOdd := errors.New("odd")
+	var calls atomic.Int32
+	stage := Map(func(_ context.Context, v int) result.Result[int] {
+		calls.Add(1)
+		if v == 1 {
+			return result.Err[int](errOdd)
+		}
+		return result.Ok(v)
+	})
+	if r := Run(context.Background(), nil, stage); !r.IsOk() {
+		t.Errorf("Expected Ok for empty input, got %v", r)
+	}
+	items := make([]int, 100)
+	items[1] = 1
+	if r := Run(context.Background(), items, stage); !r.HasErr(errOdd) {
+		t.Errorf("Expected Err(odd), got %v", r)
+	}
+	if n := calls.Load(); n == 100 {
+		t.Error("Expected fail-fast to stop processing")
+	}
+}
+
+func TestRun_CollectAllErrs(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	stage := FlatMap(func(_ context.Context, v int) []result.Result[int] {
+		switch v {
+		case 1:
+			return []result.Result[int]{result.Err[int](errA)}
+		case 2:
+			return []result.Result[int]{result.Ok(2), result.Err[int](errB)}
+		}
+		return []result.Result[int]{result.Ok(v)}
+	})
+	r := Run(context.Background(), []int{0, 1, 2, 3}, stage, CollectAllErrs())
+	if !r.HasErr(errA) || !r.HasErr(errB) {
+		t.Errorf("Expected both errors, got %v", r)
+	}
+}
+
+func TestRun_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stage := Map(func(_ context.Context, v int) result.Result[int] { return result.Ok(v) })
+	if r := Run(ctx, []int{1, 2, 3}, stage); !r.HasErr(context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", r)
+	}
+}
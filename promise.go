@@ -0,0 +1,57 @@
+package option
+
+import (
+	"context"
+	"sync"
+)
+
+// ========================== Promise =============================
+
+// 由其他 goroutine 异步设置一次的值，可安全地并发使用，零值不可用，需通过 NewPromise 创建
+type Promise[T any] struct {
+	once sync.Once
+	done chan struct{}
+	val  T
+}
+
+func NewPromise[T any]() *Promise[T] {
+	return &Promise[T]{done: make(chan struct{})}
+}
+
+// 设置值，只有第一次调用生效，返回是否生效
+func (p *Promise[T]) Set(v T) bool {
+	set := false
+	p.once.Do(func() {
+		p.val = v
+		set = true
+		close(p.done)
+	})
+	return set
+}
+
+// 值被设置时关闭的 channel
+func (p *Promise[T]) Done() <-chan struct{} {
+	return p.done
+}
+
+// 不阻塞地读取值，尚未设置时返回 Nul
+func (p *Promise[T]) Peek() Option[T] {
+	select {
+	case <-p.done:
+		return Val(p.val)
+	default:
+		return Nul[T]()
+	}
+}
+
+// 阻塞等待值被设置，ctx 先被取消时返回 context.Cause(ctx)。
+// 可以通过 result.From(p.Wait(ctx)) 转换为 Result
+func (p *Promise[T]) Wait(ctx context.Context) (T, error) {
+	select {
+	case <-p.done:
+		return p.val, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, context.Cause(ctx)
+	}
+}
@@ -0,0 +1,30 @@
+package option
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPromise(t *testing.T) {
+	p := NewPromise[int]()
+	if o := p.Peek(); o.IsVal() {
+		t.Errorf("Expected None before Set, got %v", o)
+	}
+	go p.Set(1)
+	if v, err := p.Wait(context.Background()); v != 1 || err != nil {
+		t.Errorf("Expected (1, nil), got (%v, %v)", v, err)
+	}
+	if p.Set(2) || !p.Peek().Has(1) {
+		t.Errorf("Expected only the first Set to take effect, got %v", p.Peek())
+	}
+}
+
+func TestPromise_WaitCanceled(t *testing.T) {
+	p := NewPromise[string]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := p.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected DeadlineExceeded, got %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+package protoopt
+
+import (
+	opt "github.com/viocha/go-option"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ========================== 包装类型 =============================
+
+// 包装类型的指针为 nil 时对应 Nul，否则对应 Val(w.Value)
+
+func FromStringValue(w *wrapperspb.StringValue) opt.Option[string] {
+	if w == nil {
+		return opt.Nul[string]()
+	}
+	return opt.Val(w.Value)
+}
+
+func ToStringValue(o opt.Option[string]) *wrapperspb.StringValue {
+	if o.IsNul() {
+		return nil
+	}
+	return wrapperspb.String(o.Get())
+}
+
+func FromBoolValue(w *wrapperspb.BoolValue) opt.Option[bool] {
+	if w == nil {
+		return opt.Nul[bool]()
+	}
+	return opt.Val(w.Value)
+}
+
+func ToBoolValue(o opt.Option[bool]) *wrapperspb.BoolValue {
+	if o.IsNul() {
+		return nil
+	}
+	return wrapperspb.Bool(o.Get())
+}
+
+func FromInt32Value(w *wrapperspb.Int32Value) opt.Option[int32] {
+	if w == nil {
+		return opt.Nul[int32]()
+	}
+	return opt.Val(w.Value)
+}
+
+func ToInt32Value(o opt.Option[int32]) *wrapperspb.Int32Value {
+	if o.IsNul() {
+		return nil
+	}
+	return wrapperspb.Int32(o.Get())
+}
+
+func FromInt64Value(w *wrapperspb.Int64Value) opt.Option[int64] {
+	if w == nil {
+		return opt.Nul[int64]()
+	}
+	return opt.Val(w.Value)
+}
+
+func ToInt64Value(o opt.Option[int64]) *wrapperspb.Int64Value {
+	if o.IsNul() {
+		return nil
+	}
+	return wrapperspb.Int64(o.Get())
+}
+
+func FromUInt32Value(w *wrapperspb.UInt32Value) opt.Option[uint32] {
+	if w == nil {
+		return opt.Nul[uint32]()
+	}
+	return opt.Val(w.Value)
+}
+
+func ToUInt32Value(o opt.Option[uint32]) *wrapperspb.UInt32Value {
+	if o.IsNul() {
+		return nil
+	}
+	return wrapperspb.UInt32(o.Get())
+}
+
+func FromUInt64Value(w *wrapperspb.UInt64Value) opt.Option[uint64] {
+	if w == nil {
+		return opt.Nul[uint64]()
+	}
+	return opt.Val(w.Value)
+}
+
+func ToUInt64Value(o opt.Option[uint64]) *wrapperspb.UInt64Value {
+	if o.IsNul() {
+		return nil
+	}
+	return wrapperspb.UInt64(o.Get())
+}
+
+func FromFloatValue(w *wrapperspb.FloatValue) opt.Option[float32] {
+	if w == nil {
+		return opt.Nul[float32]()
+	}
+	return opt.Val(w.Value)
+}
+
+func ToFloatValue(o opt.Option[float32]) *wrapperspb.FloatValue {
+	if o.IsNul() {
+		return nil
+	}
+	return wrapperspb.Float(o.Get())
+}
+
+func FromDoubleValue(w *wrapperspb.DoubleValue) opt.Option[float64] {
+	if w == nil {
+		return opt.Nul[float64]()
+	}
+	return opt.Val(w.Value)
+}
+
+func ToDoubleValue(o opt.Option[float64]) *wrapperspb.DoubleValue {
+	if o.IsNul() {
+		return nil
+	}
+	return wrapperspb.Double(o.Get())
+}
+
+func FromBytesValue(w *wrapperspb.BytesValue) opt.Option[[]byte] {
+	if w == nil {
+		return opt.Nul[[]byte]()
+	}
+	return opt.Val(w.Value)
+}
+
+func ToBytesValue(o opt.Option[[]byte]) *wrapperspb.BytesValue {
+	if o.IsNul() {
+		return nil
+	}
+	return wrapperspb.Bytes(o.Get())
+}
+
+// ========================== 字段存在性 =============================
+
+// proto3 optional 标量字段生成的是指针类型，可以直接使用 option.FromPtr 和 Option.ToPtr 转换。
+// Field 则通过反射按字段名读取任意消息的字段：字段不存在或未设置（protoreflect.Message.Has 为 false）时返回 Nul，
+// 消息类型的字段以 proto.Message 的形式返回，枚举字段以 protoreflect.EnumNumber 的形式返回
+func Field[T any](msg protoreflect.ProtoMessage, name protoreflect.Name) opt.Option[T] {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || !m.Has(fd) {
+		return opt.Nul[T]()
+	}
+	v := m.Get(fd)
+	if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+		return opt.As[T](v.Message().Interface())
+	}
+	return opt.As[T](v.Interface())
+}
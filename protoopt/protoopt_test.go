@@ -0,0 +1,43 @@
+package protoopt
+
+import (
+	"testing"
+
+	opt "github.com/viocha/go-option"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestWrappers(t *testing.T) {
+	if o := FromStringValue(wrapperspb.String("")); !o.Has("") {
+		t.Errorf("Expected Some(\"\"), got %v", o)
+	}
+	if o := FromInt64Value(nil); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if w := ToDoubleValue(opt.Val(1.5)); w.GetValue() != 1.5 {
+		t.Errorf("Expected wrapper of 1.5, got %v", w)
+	}
+	if w := ToBoolValue(opt.Nul[bool]()); w != nil {
+		t.Errorf("Expected nil wrapper, got %v", w)
+	}
+}
+
+func TestField(t *testing.T) {
+	api := &apipb.Api{Name: "svc", SourceContext: &sourcecontextpb.SourceContext{FileName: "a.proto"}}
+	if o := Field[string](api, "name"); !o.Has("svc") {
+		t.Errorf("Expected Some(svc), got %v", o)
+	}
+	if o := Field[string](api, "version"); o.IsVal() {
+		t.Errorf("Expected None for an unset field, got %v", o)
+	}
+	sc := Field[*sourcecontextpb.SourceContext](api, "source_context")
+	if !sc.HasFunc(func(s *sourcecontextpb.SourceContext) bool { return proto.Equal(s, api.SourceContext) }) {
+		t.Errorf("Expected the message field, got %v", sc)
+	}
+	if o := Field[string](api, "missing"); o.IsVal() {
+		t.Errorf("Expected None for an unknown field, got %v", o)
+	}
+}
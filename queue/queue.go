@@ -0,0 +1,131 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/viocha/go-option/result"
+)
+
+var (
+	ErrClosed = errors.New("queue closed")
+)
+
+// 队列的指标回调，未设置的回调会被忽略
+type Hooks struct {
+	OnPush  func(depth int) // 入队后调用，depth 为当前队列长度
+	OnPop   func(depth int) // 出队后调用
+	OnBlock func()          // 队列已满、Push 需要等待时调用
+}
+
+type Option func(*config)
+
+type config struct {
+	hooks Hooks
+}
+
+func WithHooks(h Hooks) Option {
+	return func(c *config) { c.hooks = h }
+}
+
+// 有界的 Result 队列，队列满时 Push 阻塞，队列关闭后 Pop 在取完剩余元素后返回终止错误
+type Queue[T any] struct {
+	items chan result.Result[T]
+	done  chan struct{}
+	hooks Hooks
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func New[T any](capacity int, opts ...Option) *Queue[T] {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &Queue[T]{
+		items: make(chan result.Result[T], capacity),
+		done:  make(chan struct{}),
+		hooks: c.hooks,
+	}
+}
+
+// 入队，队列满时阻塞，队列关闭后返回 ErrClosed
+func (q *Queue[T]) Push(r result.Result[T]) error {
+	return q.PushCtx(context.Background(), r)
+}
+
+// 入队，队列满时阻塞直到 ctx 结束
+func (q *Queue[T]) PushCtx(ctx context.Context, r result.Result[T]) error {
+	select {
+	case <-q.done:
+		return ErrClosed
+	default:
+	}
+	select {
+	case q.items <- r:
+		q.pushed()
+		return nil
+	default:
+	}
+	if q.hooks.OnBlock != nil {
+		q.hooks.OnBlock()
+	}
+	select {
+	case q.items <- r:
+		q.pushed()
+		return nil
+	case <-q.done:
+		return ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// 出队，队列为空时阻塞。队列关闭且为空时返回终止错误，ctx 结束时返回 ctx 的错误
+func (q *Queue[T]) Pop(ctx context.Context) result.Result[T] {
+	select {
+	case r := <-q.items:
+		q.popped()
+		return r
+	case <-q.done:
+		select {
+		case r := <-q.items:
+			q.popped()
+			return r
+		default:
+			return result.Err[T](q.closeErr)
+		}
+	case <-ctx.Done():
+		return result.Err[T](ctx.Err())
+	}
+}
+
+// 关闭队列，err 作为之后 Pop 返回的终止错误，为 nil 时使用 ErrClosed
+func (q *Queue[T]) Close(err error) {
+	q.closeOnce.Do(func() {
+		if err == nil {
+			err = ErrClosed
+		}
+		q.closeErr = err
+		close(q.done)
+	})
+}
+
+// 当前队列长度
+func (q *Queue[T]) Len() int {
+	return len(q.items)
+}
+
+func (q *Queue[T]) pushed() {
+	if q.hooks.OnPush != nil {
+		q.hooks.OnPush(len(q.items))
+	}
+}
+
+func (q *Queue[T]) popped() {
+	if q.hooks.OnPop != nil {
+		q.hooks.OnPop(len(q.items))
+	}
+}
@@ -0,0 +1,75 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestQueue_PushPop(t *testing.T) {
+	q := New[int](2)
+	errVal := errors.New("item error")
+	q.Push(result.Ok(1))
+	q.Push(result.Err[int](errVal))
+
+	ctx := context.Background()
+	if r := q.Pop(ctx); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	if r := q.Pop(ctx); !r.HasErr(errVal) {
+		t.Errorf("Expected Err(item error), got %v", r)
+	}
+}
+
+func TestQueue_Close(t *testing.T) {
+	errDone := errors.New("producer done")
+	q := New[int](2)
+	q.Push(result.Ok(1))
+	q.Close(errDone)
+
+	if err := q.Push(result.Ok(2)); !errors.Is(err, ErrClosed) {
+		t.Errorf("Expected ErrClosed on Push after Close, got %v", err)
+	}
+	ctx := context.Background()
+	if r := q.Pop(ctx); !r.Has(1) {
+		t.Errorf("Expected remaining Ok(1) after Close, got %v", r)
+	}
+	if r := q.Pop(ctx); !r.HasErr(errDone) {
+		t.Errorf("Expected terminal error, got %v", r)
+	}
+}
+
+func TestQueue_Backpressure(t *testing.T) {
+	blocked := make(chan struct{}, 1)
+	pushes := 0
+	q := New[int](1, WithHooks(Hooks{
+		OnPush:  func(int) { pushes++ },
+		OnBlock: func() { blocked <- struct{}{} },
+	}))
+	q.Push(result.Ok(1))
+
+	done := make(chan error)
+	go func() { done <- q.Push(result.Ok(2)) }()
+	<-blocked
+	if r := q.Pop(context.Background()); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("Expected blocked Push to succeed, got %v", err)
+	}
+	if pushes != 2 {
+		t.Errorf("Expected OnPush to be called twice, got %d", pushes)
+	}
+}
+
+func TestQueue_PopCtx(t *testing.T) {
+	q := New[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if r := q.Pop(ctx); !r.HasErr(context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded, got %v", r)
+	}
+}
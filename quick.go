@@ -0,0 +1,37 @@
+package option
+
+import (
+	"math/rand"
+	"reflect"
+
+	"github.com/viocha/go-option/internal/quickval"
+)
+
+// ========================== 随机生成 =============================
+
+// 实现 testing/quick.Generator：以相同的概率生成 Nul 或 Val，值按 testing/quick.Value 的规则生成；
+// T 无法随机生成时总是返回 Nul
+func (Option[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(generate(r, func(r *rand.Rand) Option[T] {
+		v, ok := quickval.Value(reflect.TypeFor[T](), r, size)
+		if !ok {
+			return Nul[T]()
+		}
+		return Val(v.Interface().(T))
+	}))
+}
+
+// 由值的生成函数构造 Option 的生成函数，以相同的概率生成 Nul 或 Val(gen(r))，
+// 可以用于 testing/quick.Config.Values 中
+func Generator[T any](gen func(r *rand.Rand) T) func(r *rand.Rand) Option[T] {
+	return func(r *rand.Rand) Option[T] {
+		return generate(r, func(r *rand.Rand) Option[T] { return Val(gen(r)) })
+	}
+}
+
+func generate[T any](r *rand.Rand, val func(*rand.Rand) Option[T]) Option[T] {
+	if r.Intn(2) == 0 {
+		return Nul[T]()
+	}
+	return val(r)
+}
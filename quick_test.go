@@ -0,0 +1,35 @@
+package option
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func TestGenerate(t *testing.T) {
+	var vals, nuls int
+	f := func(o Option[[]int]) bool {
+		if o.IsVal() {
+			vals++
+		} else {
+			nuls++
+		}
+		return o.IsVal() == (o.ToPtr() != nil)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 200}); err != nil {
+		t.Error(err)
+	}
+	if vals == 0 || nuls == 0 {
+		t.Errorf("Expected both Val and Nul, got %d/%d", vals, nuls)
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	gen := Generator(func(r *rand.Rand) int { return r.Intn(10) })
+	r := rand.New(rand.NewSource(1))
+	for range 50 {
+		if o := gen(r); o.IsVal() && (o.Get() < 0 || o.Get() >= 10) {
+			t.Errorf("Expected value from gen, got %v", o)
+		}
+	}
+}
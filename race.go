@@ -0,0 +1,32 @@
+package option
+
+import (
+	"context"
+
+	"github.com/viocha/go-option/internal/must"
+)
+
+// ========================== 竞速 =============================
+
+// 并发执行所有函数，返回第一个存在值的结果并取消其余函数的上下文；都不存在值时返回 Nul。
+// 函数中的 ErrMust panic 视为 Nul
+func Race[T any](ctx context.Context, fns ...func(context.Context) Option[T]) Option[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan Option[T], len(fns))
+	for _, f := range fns {
+		go func() {
+			var o Option[T]
+			if must.CatchMustPanic(func() { o = f(ctx) }) != nil {
+				o = Nul[T]()
+			}
+			results <- o
+		}()
+	}
+	for range fns {
+		if o := <-results; o.IsVal() {
+			return o
+		}
+	}
+	return Nul[T]()
+}
@@ -0,0 +1,34 @@
+package option
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRace(t *testing.T) {
+	cancelled := make(chan struct{})
+	o := Race(context.Background(),
+		func(ctx context.Context) Option[string] { return Nul[string]() },
+		func(ctx context.Context) Option[string] {
+			time.Sleep(5 * time.Millisecond)
+			return Val("fast")
+		},
+		func(ctx context.Context) Option[string] {
+			<-ctx.Done()
+			close(cancelled)
+			return Val("slow")
+		},
+	)
+	if !o.Has("fast") {
+		t.Errorf("Expected Some(fast), got %v", o)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Error("Expected the losing function to be cancelled")
+	}
+	if o := Race[int](context.Background()); o.IsVal() {
+		t.Errorf("Expected None without functions, got %v", o)
+	}
+}
@@ -0,0 +1,147 @@
+package replay
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/viocha/go-option/result"
+)
+
+var (
+	ErrNoRecording = errors.New("no recording for request")
+)
+
+// 运行模式
+type Mode int
+
+const (
+	Record Mode = iota // 调用被包装的函数，并保存其结果
+	Replay             // 不调用被包装的函数，直接返回保存的结果
+)
+
+// 录制结果的存储
+type Store interface {
+	Load(key string) ([]byte, bool)
+	Save(key string, data []byte) error
+}
+
+// 录制的结果，错误只保存其文本
+type record[T any] struct {
+	Val   T
+	Err   string
+	IsErr bool
+}
+
+// 录制或回放 Result 函数的调用结果，以请求的哈希为键
+type Recorder[K any, T any] struct {
+	mode  Mode
+	store Store
+}
+
+func New[K any, T any](mode Mode, store Store) *Recorder[K, T] {
+	return &Recorder[K, T]{mode: mode, store: store}
+}
+
+// 包装函数 f，在录制模式下保存其结果，在回放模式下返回保存的结果
+func (r *Recorder[K, T]) Wrap(f func(K) result.Result[T]) func(K) result.Result[T] {
+	return func(req K) result.Result[T] {
+		key, err := Key(req)
+		if err != nil {
+			return result.Err[T](err)
+		}
+		if r.mode == Replay {
+			return r.load(key)
+		}
+		res := f(req)
+		if err := r.save(key, res); err != nil {
+			return result.Err[T](err)
+		}
+		return res
+	}
+}
+
+func (r *Recorder[K, T]) load(key string) result.Result[T] {
+	data, ok := r.store.Load(key)
+	if !ok {
+		return result.Err[T](fmt.Errorf("%w: %s", ErrNoRecording, key))
+	}
+	var rec record[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&rec); err != nil {
+		return result.Err[T](err)
+	}
+	if rec.IsErr {
+		return result.Err[T](errors.New(rec.Err))
+	}
+	return result.Ok(rec.Val)
+}
+
+func (r *Recorder[K, T]) save(key string, res result.Result[T]) error {
+	val, err := res.Unpack()
+	rec := record[T]{Val: val}
+	if err != nil {
+		rec.IsErr, rec.Err = true, err.Error()
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(rec); err != nil {
+		return err
+	}
+	return r.store.Save(key, buf.Bytes())
+}
+
+// 计算请求的哈希，请求需要能被 gob 编码
+func Key[K any](req K) (string, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&req); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ========================== 存储实现 =============================
+
+// 基于内存的存储，可安全地并发使用
+type MemStore struct {
+	mu   sync.RWMutex
+	data map[string][]byte
+}
+
+func NewMemStore() *MemStore {
+	return &MemStore{data: make(map[string][]byte)}
+}
+
+func (s *MemStore) Load(key string) ([]byte, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.data[key]
+	return data, ok
+}
+
+func (s *MemStore) Save(key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] = data
+	return nil
+}
+
+// 基于目录的存储，每个结果保存为一个文件
+type DirStore string
+
+func (d DirStore) Load(key string) ([]byte, bool) {
+	data, err := os.ReadFile(filepath.Join(string(d), key))
+	return data, err == nil
+}
+
+func (d DirStore) Save(key string, data []byte) error {
+	if err := os.MkdirAll(string(d), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(string(d), key), data, 0o644)
+}
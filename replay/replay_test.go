@@ -0,0 +1,50 @@
+package replay
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	store := DirStore(t.TempDir())
+	calls := 0
+	f := func(n int) result.Result[string] {
+		calls++
+		if n < 0 {
+			return result.Err[string](errors.New("negative"))
+		}
+		return result.Ok("ok")
+	}
+
+	rec := New[int, string](Record, store).Wrap(f)
+	if r := rec(1); !r.Has("ok") {
+		t.Errorf("Expected Ok(ok) while recording, got %v", r)
+	}
+	rec(-1)
+
+	rep := New[int, string](Replay, store).Wrap(f)
+	if r := rep(1); !r.Has("ok") {
+		t.Errorf("Expected replayed Ok(ok), got %v", r)
+	}
+	if r := rep(-1); !r.HasErrFunc(func(e error) bool { return e.Error() == "negative" }) {
+		t.Errorf("Expected replayed Err(negative), got %v", r)
+	}
+	if calls != 2 {
+		t.Errorf("Expected wrapped func not to be called in replay mode, got %d calls", calls)
+	}
+	if r := rep(2); !r.HasErr(ErrNoRecording) {
+		t.Errorf("Expected ErrNoRecording for unknown request, got %v", r)
+	}
+}
+
+func TestMemStore(t *testing.T) {
+	store := NewMemStore()
+	rec := New[string, int](Record, store).Wrap(func(s string) result.Result[int] { return result.Ok(len(s)) })
+	rec("abc")
+	rep := New[string, int](Replay, store).Wrap(nil)
+	if r := rep("abc"); !r.Has(3) {
+		t.Errorf("Expected replayed Ok(3), got %v", r)
+	}
+}
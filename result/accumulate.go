@@ -0,0 +1,53 @@
+package result
+
+import "errors"
+
+// =========================== 错误累积 ============================
+
+// 对 v 执行所有检查，全部通过时返回 Ok(v)，否则返回所有失败检查的错误合并，不会在第一个错误处停止
+func Validate[T any](v T, checks ...func(T) error) Result[T] {
+	var errs []error
+	for _, check := range checks {
+		r := callSource(func() Result[struct{}] { return From(struct{}{}, check(v)) })
+		if r.IsErr() {
+			errs = append(errs, r.err)
+		}
+	}
+	if len(errs) > 0 {
+		return Err[T](errors.Join(errs...))
+	}
+	return Ok(v)
+}
+
+// 均为 Ok 时使用f组合其值，否则返回所有错误的合并（与 Map2 不同，不会只返回第一个 Err）
+func Accumulate2[A, B, U any](a Result[A], b Result[B], f func(A, B) U) Result[U] {
+	if err := joinErrs(a, b); err != nil {
+		return Propagate[U](err)
+	}
+	return FromFunc(func() U { return f(a.Get(), b.Get()) })
+}
+
+// 均为 Ok 时使用f组合其值，否则返回所有错误的合并
+func Accumulate3[A, B, C, U any](a Result[A], b Result[B], c Result[C], f func(A, B, C) U) Result[U] {
+	if err := joinErrs(a, b, c); err != nil {
+		return Propagate[U](err)
+	}
+	return FromFunc(func() U { return f(a.Get(), b.Get(), c.Get()) })
+}
+
+// 均为 Ok 时使用f组合其值，否则返回所有错误的合并
+func Accumulate4[A, B, C, D, U any](a Result[A], b Result[B], c Result[C], d Result[D], f func(A, B, C, D) U) Result[U] {
+	if err := joinErrs(a, b, c, d); err != nil {
+		return Propagate[U](err)
+	}
+	return FromFunc(func() U { return f(a.Get(), b.Get(), c.Get(), d.Get()) })
+}
+
+// 合并任意类型的 Result 中的错误，都为 Ok 时返回 nil
+func joinErrs(rs ...errSource) error {
+	var errs []error
+	for _, r := range rs {
+		r.Err().Try(func(err error) { errs = append(errs, err) })
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,52 @@
+package result
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type signup struct {
+	Name string
+	Age  int
+}
+
+func TestValidate(t *testing.T) {
+	errName, errAge := errors.New("name is required"), errors.New("age must be positive")
+	checks := []func(signup) error{
+		func(s signup) error {
+			if strings.TrimSpace(s.Name) == "" {
+				return errName
+			}
+			return nil
+		},
+		func(s signup) error {
+			if s.Age <= 0 {
+				return errAge
+			}
+			return nil
+		},
+	}
+	if r := Validate(signup{Name: "ann", Age: 3}, checks...); r.IsErr() {
+		t.Errorf("Expected Ok, got %v", r)
+	}
+	if r := Validate(signup{}, checks...); !r.HasErr(errName) || !r.HasErr(errAge) {
+		t.Errorf("Expected both errors, got %v", r)
+	}
+}
+
+func TestAccumulate(t *testing.T) {
+	err1, err2 := errors.New("e1"), errors.New("e2")
+	concat := func(a string, b int, c bool) string { return a + strings.Repeat("!", b) }
+
+	if r := Accumulate3(Ok("hi"), Ok(2), Ok(true), concat); !r.Has("hi!!") {
+		t.Errorf("Expected Ok(hi!!), got %v", r)
+	}
+	r := Accumulate3(Err[string](err1), Ok(2), Err[bool](err2), concat)
+	if !r.HasErr(err1) || !r.HasErr(err2) {
+		t.Errorf("Expected both errors, got %v", r)
+	}
+	if r := Accumulate2(Ok(1), Err[int](err2), func(a, b int) int { return a + b }); !r.HasErr(err2) {
+		t.Errorf("Expected Err(e2), got %v", r)
+	}
+}
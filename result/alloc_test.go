@@ -0,0 +1,29 @@
+//go:build !race
+
+package result
+
+import "testing"
+
+// 热路径上的操作不应产生堆分配，防止性能回退
+func TestAllocs(t *testing.T) {
+	r := Ok(1)
+	e := Err[int](errBenchNotFound)
+	cases := []struct {
+		name string
+		f    func()
+	}{
+		{"Ok", func() { r = Ok(2) }},
+		{"Err", func() { e = Err[int](errBenchNotFound) }},
+		{"Get", func() { _ = r.Get() }},
+		{"GetOr", func() { _ = e.GetOr(1) }},
+		{"Has", func() { _ = r.Has(1) }},
+		{"Map", func() { r = Map(r, func(v int) int { return v + 1 }) }},
+		{"MapErr", func() { e = Map(e, func(v int) int { return v + 1 }) }},
+		{"Then", func() { r = Then(r, func(v int) Result[int] { return Ok(v) }) }},
+	}
+	for _, c := range cases {
+		if n := testing.AllocsPerRun(100, c.f); n != 0 {
+			t.Errorf("%s: expected no allocations, got %v", c.name, n)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+)
+
+// =========================== 分批调用 ============================
+
+var ErrBatchSize = errors.New("batch result size mismatch")
+
+// 将 items 按 size 分批调用 f，返回与 items 顺序一致的结果。
+// 某一批失败时，该批中每个元素的结果均为该错误；f 返回的结果数量与该批元素数量不一致时，该批结果为 ErrBatchSize
+func Batch[T, U any](items []T, size int, f func([]T) Result[[]U]) []Result[U] {
+	if size <= 0 {
+		size = len(items)
+	}
+	results := make([]Result[U], 0, len(items))
+	for start := 0; start < len(items); start += size {
+		chunk := items[start:min(start+size, len(items))]
+		r := Then(callSource(func() Result[[]U] { return f(chunk) }), func(vals []U) Result[[]U] {
+			if len(vals) != len(chunk) {
+				return Err[[]U](fmt.Errorf("%w: got %d results for %d items", ErrBatchSize, len(vals), len(chunk)))
+			}
+			return Ok(vals)
+		})
+		if r.IsErr() {
+			for range chunk {
+				results = append(results, Propagate[U](r.err))
+			}
+			continue
+		}
+		for _, v := range r.Get() {
+			results = append(results, Ok(v))
+		}
+	}
+	return results
+}
@@ -0,0 +1,42 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	errQuota := errors.New("quota exceeded")
+	var sizes []int
+	results := Batch([]int{1, 2, 3, 4, 5}, 2, func(chunk []int) Result[[]string] {
+		sizes = append(sizes, len(chunk))
+		if chunk[0] == 3 {
+			return Err[[]string](errQuota)
+		}
+		out := make([]string, len(chunk))
+		for i, v := range chunk {
+			out[i] = string(rune('a' + v - 1))
+		}
+		return Ok(out)
+	})
+
+	if len(sizes) != 3 || sizes[2] != 1 {
+		t.Errorf("Expected batches of 2, 2, 1, got %v", sizes)
+	}
+	if len(results) != 5 {
+		t.Fatalf("Expected 5 results, got %d", len(results))
+	}
+	if !results[0].Has("a") || !results[1].Has("b") || !results[4].Has("e") {
+		t.Errorf("Expected results aligned with input order, got %v", results)
+	}
+	if !results[2].HasErr(errQuota) || !results[3].HasErr(errQuota) {
+		t.Errorf("Expected failed batch to map onto its items, got %v", results)
+	}
+}
+
+func TestBatch_SizeMismatch(t *testing.T) {
+	results := Batch([]int{1, 2}, 0, func(chunk []int) Result[[]int] { return Ok([]int{1}) })
+	if len(results) != 2 || !results[0].HasErr(ErrBatchSize) || !results[1].HasErr(ErrBatchSize) {
+		t.Errorf("Expected ErrBatchSize for every item, got %v", results)
+	}
+}
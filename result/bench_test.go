@@ -0,0 +1,105 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func BenchmarkOk(b *testing.B) {
+	b.ReportAllocs()
+	var r Result[int]
+	for i := range b.N {
+		r = Ok(i)
+	}
+	_ = r
+}
+
+func BenchmarkGet(b *testing.B) {
+	b.ReportAllocs()
+	r := Ok(1)
+	sum := 0
+	for range b.N {
+		sum += r.Get()
+	}
+	_ = sum
+}
+
+func BenchmarkGetOr(b *testing.B) {
+	b.ReportAllocs()
+	r := Ok(1)
+	sum := 0
+	for range b.N {
+		sum += r.GetOr(0)
+	}
+	_ = sum
+}
+
+func BenchmarkMap(b *testing.B) {
+	b.ReportAllocs()
+	r := Ok(1)
+	for range b.N {
+		r = Map(r, func(v int) int { return v + 1 })
+	}
+	_ = r
+}
+
+var errBenchNotFound = errors.New("not found")
+
+func BenchmarkErrCached(b *testing.B) {
+	b.ReportAllocs()
+	var r Result[int]
+	for range b.N {
+		r = ErrCached[int](errBenchNotFound)
+	}
+	_ = r
+}
+
+func BenchmarkErr(b *testing.B) {
+	b.ReportAllocs()
+	var r Result[int]
+	for range b.N {
+		r = Err[int](errBenchNotFound)
+	}
+	_ = r
+}
+
+func BenchmarkThen(b *testing.B) {
+	b.ReportAllocs()
+	r := Ok(1)
+	for range b.N {
+		r = Then(r, func(v int) Result[int] { return Ok(v + 1) })
+	}
+	_ = r
+}
+
+func BenchmarkChain(b *testing.B) {
+	b.ReportAllocs()
+	sum := 0
+	for i := range b.N {
+		r := Map(Ok(i), func(v int) int { return v * 2 })
+		sum += Then(r, func(v int) Result[int] { return Ok(v + 1) }).GetOr(0)
+	}
+	_ = sum
+}
+
+func BenchmarkHas(b *testing.B) {
+	b.ReportAllocs()
+	r := Ok(1)
+	n := 0
+	for range b.N {
+		if r.Has(1) {
+			n++
+		}
+	}
+	_ = n
+}
+
+func BenchmarkString(b *testing.B) {
+	b.ReportAllocs()
+	r := Ok(1)
+	var s string
+	for range b.N {
+		s = r.String()
+	}
+	_ = s
+}
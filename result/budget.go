@@ -0,0 +1,59 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// =========================== 时间预算 ============================
+
+var ErrBudgetExceeded = errors.New("time budget exceeded")
+
+// 整条调用链共享的时间预算
+type Budget struct {
+	ctx      context.Context
+	deadline time.Time
+}
+
+// 创建一个从现在开始、总时长为 total 的预算，ctx 的截止时间更早时以 ctx 为准
+func NewBudget(ctx context.Context, total time.Duration) *Budget {
+	deadline := time.Now().Add(total)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	return &Budget{ctx: ctx, deadline: deadline}
+}
+
+// 剩余的预算，已耗尽时返回 0
+func (b *Budget) Remaining() time.Duration {
+	return max(time.Until(b.deadline), 0)
+}
+
+// 返回一个在预算耗尽时取消的上下文
+func (b *Budget) Context() (context.Context, context.CancelFunc) {
+	return context.WithDeadline(b.ctx, b.deadline)
+}
+
+// 在预算内执行 f，预算已耗尽时不调用 f，直接返回 ErrBudgetExceeded
+func WithBudget[T any](b *Budget, f func(context.Context) Result[T]) Result[T] {
+	if b.Remaining() <= 0 {
+		return Err[T](ErrBudgetExceeded)
+	}
+	if err := b.ctx.Err(); err != nil {
+		return Err[T](err)
+	}
+	ctx, cancel := b.Context()
+	defer cancel()
+	return callSource(func() Result[T] { return f(ctx) })
+}
+
+// Ok 时在剩余预算内调用f，f 接收到的上下文会在预算耗尽时取消
+func ThenBudget[T, U any](r Result[T], b *Budget, f func(context.Context, T) Result[U]) Result[U] {
+	if r.IsErr() {
+		return Propagate[U](r.err)
+	}
+	return WithBudget(b, func(ctx context.Context) Result[U] {
+		return f(ctx, r.Get())
+	})
+}
@@ -0,0 +1,49 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBudget(t *testing.T) {
+	b := NewBudget(context.Background(), 50*time.Millisecond)
+	r := WithBudget(b, func(ctx context.Context) Result[int] {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Error("Expected step context to carry the budget deadline")
+		}
+		return Ok(1)
+	})
+	r2 := ThenBudget(r, b, func(ctx context.Context, v int) Result[int] {
+		<-ctx.Done()
+		return Err[int](ctx.Err())
+	})
+	if !r2.HasErr(context.DeadlineExceeded) {
+		t.Errorf("Expected the step to run out of budget, got %v", r2)
+	}
+
+	called := false
+	r3 := ThenBudget(r, b, func(ctx context.Context, v int) Result[int] {
+		called = true
+		return Ok(v)
+	})
+	if called || !r3.HasErr(ErrBudgetExceeded) {
+		t.Errorf("Expected ErrBudgetExceeded without calling the step, got %v", r3)
+	}
+	if b.Remaining() != 0 {
+		t.Errorf("Expected no remaining budget, got %v", b.Remaining())
+	}
+}
+
+func TestBudget_PropagatesErr(t *testing.T) {
+	b := NewBudget(context.Background(), time.Second)
+	errVal := errors.New("err")
+	r := ThenBudget(Err[int](errVal), b, func(ctx context.Context, v int) Result[int] {
+		t.Error("ThenBudget func called on Err")
+		return Ok(v)
+	})
+	if !r.HasErr(errVal) {
+		t.Errorf("Expected original error, got %v", r)
+	}
+}
@@ -0,0 +1,69 @@
+package result
+
+import (
+	"errors"
+	"iter"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/internal/common"
+)
+
+// =========================== 错误链 ============================
+
+// 按深度优先的顺序展开错误链（包括 errors.Join 形成的树），第一个元素为错误本身。Ok 时返回 nil
+func (r Result[T]) Causes() []error {
+	var causes []error
+	common.WalkErr(r.err, func(err error, _ int) {
+		causes = append(causes, err)
+	})
+	return causes
+}
+
+// 错误链中最深的错误，深度相同时取靠前的一个。Ok 时返回 nil
+func (r Result[T]) RootCause() error {
+	var root error
+	maxDepth := -1
+	common.WalkErr(r.err, func(err error, depth int) {
+		if depth > maxDepth {
+			root, maxDepth = err, depth
+		}
+	})
+	return root
+}
+
+// 与 Causes 相同，但以迭代器的形式按深度优先的顺序惰性地遍历错误链，可以提前停止。Ok 时不产生任何元素
+func (r Result[T]) ErrChain() iter.Seq[error] {
+	return func(yield func(error) bool) {
+		var walk func(err error) bool
+		walk = func(err error) bool {
+			if !yield(err) {
+				return false
+			}
+			switch e := err.(type) {
+			case interface{ Unwrap() error }:
+				if inner := e.Unwrap(); inner != nil {
+					return walk(inner)
+				}
+			case interface{ Unwrap() []error }:
+				for _, inner := range e.Unwrap() {
+					if inner != nil && !walk(inner) {
+						return false
+					}
+				}
+			}
+			return true
+		}
+		if r.err != nil {
+			walk(r.err)
+		}
+	}
+}
+
+// 使用 errors.As 在 r 的错误链中查找类型为 E 的错误，Ok 或不存在时返回 Nul
+func FindErr[E error, T any](r Result[T]) opt.Option[E] {
+	var target E
+	if r.IsOk() || !errors.As(r.err, &target) {
+		return opt.Nul[E]()
+	}
+	return opt.Val(target)
+}
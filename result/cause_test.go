@@ -0,0 +1,63 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestCauses(t *testing.T) {
+	errDisk, errNet := errors.New("disk full"), errors.New("network down")
+	wrappedDisk := fmt.Errorf("write: %w", errDisk)
+	joined := errors.Join(errNet, wrappedDisk)
+	top := fmt.Errorf("save: %w", joined)
+	r := Err[int](top)
+
+	want := []error{top, joined, errNet, wrappedDisk, errDisk}
+	if got := r.Causes(); !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if got := r.RootCause(); got != errDisk {
+		t.Errorf("Expected the deepest error, got %v", got)
+	}
+	if Ok(1).Causes() != nil || Ok(1).RootCause() != nil {
+		t.Error("Expected no causes for Ok")
+	}
+}
+
+func TestErrChain(t *testing.T) {
+	errDisk, errNet := errors.New("disk full"), errors.New("network down")
+	r := Err[int](fmt.Errorf("save: %w", errors.Join(fmt.Errorf("write: %w", errDisk), errNet)))
+	if !slices.Equal(slices.Collect(r.ErrChain()), r.Causes()) {
+		t.Errorf("Expected ErrChain to match Causes, got %v", slices.Collect(r.ErrChain()))
+	}
+	var visited int
+	for err := range r.ErrChain() {
+		visited++
+		if err == errDisk {
+			break
+		}
+	}
+	if visited != 4 {
+		t.Errorf("Expected to stop at errDisk after 4 errors, visited %d", visited)
+	}
+	if n := len(slices.Collect(Ok(1).ErrChain())); n != 0 {
+		t.Errorf("Expected no errors for Ok, got %d", n)
+	}
+}
+
+func TestFindErr(t *testing.T) {
+	r := Err[int](fmt.Errorf("load: %w", &fs.PathError{Op: "open", Path: "a.txt", Err: fs.ErrNotExist}))
+	if e := FindErr[*fs.PathError](r); !e.HasFunc(func(pe *fs.PathError) bool { return pe.Path == "a.txt" }) {
+		t.Errorf("Expected the PathError, got %v", e)
+	}
+	if e := FindErr[*strconv.NumError](r); e.IsVal() {
+		t.Errorf("Expected None, got %v", e)
+	}
+	if e := FindErr[*fs.PathError](Ok(1)); e.IsVal() {
+		t.Errorf("Expected None for Ok, got %v", e)
+	}
+}
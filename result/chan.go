@@ -0,0 +1,48 @@
+package result
+
+import (
+	"context"
+	"sync"
+)
+
+// =========================== channel ============================
+
+// 将多个 channel 中的结果合并到一个 channel，所有输入 channel 关闭后关闭返回的 channel
+func FanIn[T any](chs ...<-chan Result[T]) <-chan Result[T] {
+	out := make(chan Result[T])
+	var wg sync.WaitGroup
+	for _, ch := range chs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range ch {
+				out <- r
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// 接收 ch 中的所有结果直到其关闭，全部为 Ok 时返回所有值。
+// 遇到第一个 Err 或 ctx 被取消时立即返回，之后不再从 ch 中接收
+func CollectChan[T any](ctx context.Context, ch <-chan Result[T]) Result[[]T] {
+	var vals []T
+	for {
+		select {
+		case r, ok := <-ch:
+			if !ok {
+				return Ok(vals)
+			}
+			if r.IsErr() {
+				return Propagate[[]T](r.err)
+			}
+			vals = append(vals, r.val)
+		case <-ctx.Done():
+			return Err[[]T](context.Cause(ctx))
+		}
+	}
+}
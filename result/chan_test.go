@@ -0,0 +1,41 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func send[T any](rs ...Result[T]) <-chan Result[T] {
+	ch := make(chan Result[T], len(rs))
+	for _, r := range rs {
+		ch <- r
+	}
+	close(ch)
+	return ch
+}
+
+func TestFanIn(t *testing.T) {
+	r := CollectChan(context.Background(), FanIn(send(Ok(1), Ok(2)), send(Ok(3)), send[int]()))
+	if r.IsErr() {
+		t.Fatalf("Unexpected error: %v", r.GetErr())
+	}
+	vals := r.Get()
+	slices.Sort(vals)
+	if !slices.Equal(vals, []int{1, 2, 3}) {
+		t.Errorf("Expected all values, got %v", vals)
+	}
+}
+
+func TestCollectChan(t *testing.T) {
+	errBoom := errors.New("boom")
+	if r := CollectChan(context.Background(), send(Ok(1), Err[int](errBoom), Ok(3))); !r.HasErr(errBoom) {
+		t.Errorf("Expected Err(boom), got %v", r)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if r := CollectChan(ctx, make(chan Result[int])); !r.HasErr(context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", r)
+	}
+}
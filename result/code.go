@@ -0,0 +1,75 @@
+package result
+
+import (
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/internal/common"
+)
+
+// =========================== 错误码 ============================
+
+// 带有稳定错误码的错误，错误消息与被包装的错误相同
+type CodedError struct {
+	code string
+	err  error
+}
+
+// 构造一个只有错误码的错误，可以用作 errors.Is 的目标：errors.Is(err, result.Code("not_found"))
+func Code(code string) error {
+	return &CodedError{code: code}
+}
+
+func (e *CodedError) Code() string {
+	return e.code
+}
+
+func (e *CodedError) Error() string {
+	if e.err == nil {
+		return e.code
+	}
+	return e.err.Error()
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.err
+}
+
+// 错误码相同的 *CodedError 视为相等
+func (e *CodedError) Is(target error) bool {
+	t, ok := target.(*CodedError)
+	return ok && t.code == e.code
+}
+
+// 构造一个带有错误码的 Err
+func ErrWithCode[T any](code string, err error) Result[T] {
+	return Err[T](&CodedError{code: code, err: err})
+}
+
+// Err 时为错误附加错误码，Ok 时原样返回
+func (r Result[T]) WithCode(code string) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return ErrWithCode[T](code, r.err)
+}
+
+// 返回错误链中最外层的错误码，任何实现了 Code() string 的错误都会被识别。Ok 或没有错误码时返回 Nul
+func (r Result[T]) Code() opt.Option[string] {
+	code := opt.Nul[string]()
+	common.WalkErr(r.err, func(err error, _ int) {
+		if c, ok := err.(coder); ok && code.IsNul() {
+			code = opt.Val(c.Code())
+		}
+	})
+	return code
+}
+
+// 判断错误链中是否存在错误码为 code 的错误
+func (r Result[T]) HasCode(code string) bool {
+	found := false
+	common.WalkErr(r.err, func(err error, _ int) {
+		if c, ok := err.(coder); ok && c.Code() == code {
+			found = true
+		}
+	})
+	return found
+}
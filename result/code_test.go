@@ -0,0 +1,27 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCode(t *testing.T) {
+	errMissing := errors.New("user 7 missing")
+	r := ErrWithCode[int]("not_found", errMissing).Wrap("load").WithCode("upstream")
+	if !r.Code().Has("upstream") || !r.HasCode("not_found") || r.HasCode("internal") {
+		t.Errorf("Unexpected codes for %v: %v", r, r.Code())
+	}
+	if !r.HasErr(errMissing) || !errors.Is(r.GetErr(), Code("not_found")) {
+		t.Errorf("Expected errors.Is to match the error and its code, got %v", r)
+	}
+	var ce *CodedError
+	if !r.HasErrAs(&ce) || ce.Code() != "upstream" {
+		t.Errorf("Expected errors.As to find *CodedError, got %v", ce)
+	}
+	if r.GetErr().Error() != "load: user 7 missing" {
+		t.Errorf("Expected codes not to change the message, got %q", r.GetErr())
+	}
+	if Ok(1).WithCode("x").Code().IsVal() {
+		t.Error("Expected Ok to have no code")
+	}
+}
@@ -0,0 +1,58 @@
+package result
+
+import (
+	"errors"
+	"slices"
+	"sync"
+
+	opt "github.com/viocha/go-option"
+)
+
+// =========================== 错误收集器 ============================
+
+// 任意类型的 Result 均实现该接口
+type errSource interface {
+	Err() opt.Option[error]
+}
+
+// 收集副作用中产生的错误，可安全地并发使用
+type Collector struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+// 如果 r 为 Err，则记录其错误。r 可以是任意类型的 Result
+func (c *Collector) Add(r errSource) {
+	r.Err().Try(c.AddErr)
+}
+
+// 记录一个错误，nil 会被忽略
+func (c *Collector) AddErr(err error) {
+	if err == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errs = append(c.errs, err)
+}
+
+// 已记录的错误数量
+func (c *Collector) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.errs)
+}
+
+// 返回已记录的所有错误
+func (c *Collector) Errs() []error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.errs)
+}
+
+// 返回所有错误通过 errors.Join 合并后的错误，没有错误时返回 nil
+func (c *Collector) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return errors.Join(c.errs...)
+}
@@ -0,0 +1,46 @@
+package result
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestCollector(t *testing.T) {
+	var c Collector
+	if c.Err() != nil {
+		t.Error("Expected nil error from empty collector")
+	}
+
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	c.Add(Ok(1))
+	c.Add(Err[string](err1))
+	c.AddErr(nil)
+	c.AddErr(err2)
+
+	if c.Len() != 2 {
+		t.Errorf("Expected 2 errors, got %d", c.Len())
+	}
+	if err := c.Err(); !errors.Is(err, err1) || !errors.Is(err, err2) {
+		t.Errorf("Expected joined error, got %v", err)
+	}
+}
+
+func TestCollector_Concurrent(t *testing.T) {
+	var c Collector
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Ok(1).Try(func(int) {
+				c.AddErr(errors.New("background failure"))
+			})
+		}()
+	}
+	wg.Wait()
+	if len(c.Errs()) != 10 {
+		t.Errorf("Expected 10 errors, got %d", len(c.Errs()))
+	}
+}
@@ -0,0 +1,74 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// =========================== 上下文 ============================
+
+// 在新的 goroutine 中执行 f，ctx 先被取消时不再等待 f，直接返回 Err(context.Cause(ctx))。
+// f 应当响应 ctx 的取消，否则会在后台继续运行直到返回
+func FromFuncCtx[T any](ctx context.Context, f func(context.Context) (T, error)) Result[T] {
+	return WithContext(ctx, func(ctx context.Context) Result[T] {
+		return From(f(ctx))
+	})
+}
+
+// 与 FromFuncErr 相同（不捕获 panic），但 f 接受 ctx；ctx 已被取消时不调用 f，直接返回 Err(context.Cause(ctx))。
+// 与 FromFuncCtx 不同，f 在当前 goroutine 中执行，调用方会一直等待 f 返回
+func FromFuncCtxErr[T any](ctx context.Context, f func(context.Context) (T, error)) Result[T] {
+	if ctx.Err() != nil {
+		return Err[T](context.Cause(ctx))
+	}
+	return FromFuncErr(func() (T, error) { return f(ctx) })
+}
+
+// 在新的 goroutine 中执行 f，ctx 先被取消时不再等待 f，直接返回 Err(context.Cause(ctx))
+func WithContext[T any](ctx context.Context, f func(context.Context) Result[T]) Result[T] {
+	if ctx.Err() != nil {
+		return Err[T](context.Cause(ctx))
+	}
+	done := make(chan Result[T], 1)
+	go func() {
+		done <- callSource(func() Result[T] { return f(ctx) })
+	}()
+	select {
+	case r := <-done:
+		return r
+	case <-ctx.Done():
+		return Err[T](context.Cause(ctx))
+	}
+}
+
+// 与 WithContext 相同，但 f 最多执行 d，超时时返回 Err(context.DeadlineExceeded)
+func WithTimeout[T any](ctx context.Context, d time.Duration, f func(context.Context) Result[T]) Result[T] {
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+	return WithContext(ctx, f)
+}
+
+// 并发执行所有函数，返回第一个 Ok 并取消其余函数的上下文；都为 Err 时返回所有错误的合并，没有任何函数时返回 ErrNoCandidates
+func Race[T any](ctx context.Context, fns ...func(context.Context) Result[T]) Result[T] {
+	if len(fns) == 0 {
+		return Err[T](ErrNoCandidates)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan Result[T], len(fns))
+	for _, f := range fns {
+		go func() {
+			results <- callSource(func() Result[T] { return f(ctx) })
+		}()
+	}
+	errs := make([]error, 0, len(fns))
+	for range fns {
+		r := <-results
+		if r.IsOk() {
+			return r
+		}
+		errs = append(errs, r.err)
+	}
+	return Propagate[T](errors.Join(errs...))
+}
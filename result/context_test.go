@@ -0,0 +1,85 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFromFuncCtx(t *testing.T) {
+	r := FromFuncCtx(context.Background(), func(context.Context) (int, error) { return 42, nil })
+	if !r.Has(42) {
+		t.Errorf("Expected Ok(42), got %v", r)
+	}
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	errShutdown := errors.New("shutting down")
+	cancel(errShutdown)
+	r = FromFuncCtx(ctx, func(context.Context) (int, error) { return 1, nil })
+	if !r.HasErr(errShutdown) {
+		t.Errorf("Expected the cancellation cause, got %v", r)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	start := time.Now()
+	r := WithTimeout(context.Background(), 20*time.Millisecond, func(context.Context) Result[string] {
+		<-block // 忽略 ctx 的慢调用
+		return Ok("late")
+	})
+	if !r.HasErr(context.DeadlineExceeded) || time.Since(start) > time.Second {
+		t.Errorf("Expected DeadlineExceeded promptly, got %v after %v", r, time.Since(start))
+	}
+	r = WithTimeout(context.Background(), time.Second, func(context.Context) Result[string] { return Ok("fast") })
+	if !r.Has("fast") {
+		t.Errorf("Expected Ok(fast), got %v", r)
+	}
+}
+
+func TestRace(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+	r := Race(context.Background(),
+		func(ctx context.Context) Result[int] { return Err[int](err1) },
+		func(ctx context.Context) Result[int] {
+			time.Sleep(5 * time.Millisecond)
+			return Ok(2)
+		},
+		func(ctx context.Context) Result[int] {
+			<-ctx.Done()
+			return Err[int](ctx.Err())
+		},
+	)
+	if !r.Has(2) {
+		t.Errorf("Expected Ok(2), got %v", r)
+	}
+
+	r = Race(context.Background(),
+		func(ctx context.Context) Result[int] { return Err[int](err1) },
+		func(ctx context.Context) Result[int] { return Err[int](err2) },
+	)
+	if !r.HasErr(err1) || !r.HasErr(err2) {
+		t.Errorf("Expected all errors to be joined, got %v", r)
+	}
+	if r := Race[int](context.Background()); !r.HasErr(ErrNoCandidates) {
+		t.Errorf("Expected ErrNoCandidates, got %v", r)
+	}
+}
+
+func TestFromFuncCtxErr(t *testing.T) {
+	r := FromFuncCtxErr(context.Background(), func(context.Context) (int, error) { return 42, nil })
+	if !r.Has(42) {
+		t.Errorf("Expected Ok(42), got %v", r)
+	}
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	errShutdown := errors.New("shutting down")
+	cancel(errShutdown)
+	called := false
+	r = FromFuncCtxErr(ctx, func(context.Context) (int, error) { called = true; return 1, nil })
+	if !r.HasErr(errShutdown) || called {
+		t.Errorf("Expected the cancellation cause without calling f, got %v (called=%v)", r, called)
+	}
+}
@@ -0,0 +1,32 @@
+package result
+
+import (
+	"context"
+	"time"
+)
+
+// =========================== 截止时间拆分 ============================
+
+// 将 ctx 剩余的时间平均分配给 n 个依次执行的调用：第 i 个上下文的截止时间为
+// 现在 + (i+1) * 每份时间，因此前面的调用不会占用后面调用的份额。每份时间至少为 floor，
+// 所有截止时间都不会晚于 ctx 的截止时间。ctx 没有截止时间时，所有上下文都不设置截止时间。
+// 返回的 cancel 释放所有上下文的资源
+func SplitDeadline(ctx context.Context, n int, floor time.Duration) ([]context.Context, context.CancelFunc) {
+	ctxs := make([]context.Context, n)
+	cancels := make([]context.CancelFunc, n)
+	deadline, ok := ctx.Deadline()
+	now := time.Now()
+	slice := max(deadline.Sub(now)/time.Duration(max(n, 1)), floor)
+	for i := range n {
+		if ok {
+			ctxs[i], cancels[i] = context.WithDeadline(ctx, now.Add(slice*time.Duration(i+1)))
+		} else {
+			ctxs[i], cancels[i] = context.WithCancel(ctx)
+		}
+	}
+	return ctxs, func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package result
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSplitDeadline(t *testing.T) {
+	parent, cancelParent := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancelParent()
+	parentDeadline, _ := parent.Deadline()
+
+	ctxs, cancel := SplitDeadline(parent, 3, 0)
+	defer cancel()
+	var prev time.Time
+	for i, ctx := range ctxs {
+		d, ok := ctx.Deadline()
+		if !ok || !d.After(prev) || d.After(parentDeadline) {
+			t.Errorf("Unexpected deadline for step %d: %v", i, d)
+		}
+		prev = d
+	}
+	first, _ := ctxs[0].Deadline()
+	if remaining := time.Until(first); remaining > time.Second || remaining < 900*time.Millisecond {
+		t.Errorf("Expected the first step to get about a third of the budget, got %v", remaining)
+	}
+
+	ctxs, cancel2 := SplitDeadline(parent, 100, time.Second)
+	defer cancel2()
+	first, _ = ctxs[0].Deadline()
+	if remaining := time.Until(first); remaining < 900*time.Millisecond {
+		t.Errorf("Expected the floor to apply, got %v", remaining)
+	}
+	if last, _ := ctxs[99].Deadline(); !last.Equal(parentDeadline) {
+		t.Errorf("Expected the last deadline to be capped by the parent, got %v", last)
+	}
+}
+
+func TestSplitDeadline_NoDeadline(t *testing.T) {
+	ctxs, cancel := SplitDeadline(context.Background(), 2, time.Second)
+	if _, ok := ctxs[1].Deadline(); ok {
+		t.Error("Expected no deadline when the parent has none")
+	}
+	cancel()
+	if ctxs[0].Err() == nil {
+		t.Error("Expected cancel to cancel all contexts")
+	}
+}
@@ -0,0 +1,45 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+)
+
+// =========================== 降级 ============================
+
+// 表示由哪一级提供者返回了结果，0 为主提供者，n 为第 n 个降级提供者
+type QualityLevel int
+
+const (
+	QualityUnavailable QualityLevel = -1 // 所有提供者均失败
+	QualityFull        QualityLevel = 0  // 主提供者成功
+)
+
+// 是否由降级提供者返回了结果
+func (q QualityLevel) IsDegraded() bool {
+	return q > QualityFull
+}
+
+func (q QualityLevel) String() string {
+	switch q {
+	case QualityUnavailable:
+		return "Unavailable"
+	case QualityFull:
+		return "Full"
+	}
+	return fmt.Sprintf("Degraded(%d)", int(q))
+}
+
+// 依次尝试 primary 和 degraded 中的提供者，返回第一个 Ok 以及提供该结果的级别。
+// 所有提供者均失败时返回合并后的错误以及 QualityUnavailable
+func Degrade[T any](primary func() Result[T], degraded ...func() Result[T]) (Result[T], QualityLevel) {
+	var errs []error
+	for i, f := range append([]func() Result[T]{primary}, degraded...) {
+		r := callSource(f)
+		if r.IsOk() {
+			return r, QualityLevel(i)
+		}
+		errs = append(errs, r.err)
+	}
+	return Propagate[T](errors.Join(errs...)), QualityUnavailable
+}
@@ -0,0 +1,26 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDegrade(t *testing.T) {
+	errDown := errors.New("down")
+	fail := func() Result[string] { return Err[string](errDown) }
+
+	r, q := Degrade(func() Result[string] { return Ok("fresh") }, fail)
+	if !r.Has("fresh") || q != QualityFull || q.IsDegraded() {
+		t.Errorf("Expected fresh value at full quality, got %v %v", r, q)
+	}
+
+	r, q = Degrade(fail, fail, func() Result[string] { return Ok("stale") })
+	if !r.Has("stale") || q != 2 || !q.IsDegraded() {
+		t.Errorf("Expected stale value at level 2, got %v %v", r, q)
+	}
+
+	r, q = Degrade(fail, fail)
+	if !r.HasErr(errDown) || q != QualityUnavailable {
+		t.Errorf("Expected Err at unavailable quality, got %v %v", r, q)
+	}
+}
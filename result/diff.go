@@ -0,0 +1,40 @@
+package result
+
+// =========================== 状态变化 ============================
+
+// 两个 Result 之间的状态变化
+type Transition int
+
+const (
+	Unchanged    Transition = iota // 状态与值均未变化（Err 到 Err 视为未变化）
+	OkToErr                        // 由 Ok 变为 Err
+	ErrToOk                        // 由 Err 变为 Ok
+	ValueChanged                   // 均为 Ok，但值发生变化
+)
+
+func (t Transition) String() string {
+	switch t {
+	case Unchanged:
+		return "Unchanged"
+	case OkToErr:
+		return "OkToErr"
+	case ErrToOk:
+		return "ErrToOk"
+	case ValueChanged:
+		return "ValueChanged"
+	}
+	return "Transition(?)"
+}
+
+// 比较 old 和 new 两个 Result，返回其状态变化，eq 用于比较两个 Ok 值是否相等
+func Diff[T any](old, new Result[T], eq func(T, T) bool) Transition {
+	switch {
+	case old.IsOk() && new.IsErr():
+		return OkToErr
+	case old.IsErr() && new.IsOk():
+		return ErrToOk
+	case old.IsOk() && new.IsOk() && !eq(old.Get(), new.Get()):
+		return ValueChanged
+	}
+	return Unchanged
+}
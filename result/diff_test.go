@@ -0,0 +1,26 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+	errVal := errors.New("err")
+	cases := []struct {
+		old, new Result[int]
+		want     Transition
+	}{
+		{Ok(1), Ok(1), Unchanged},
+		{Ok(1), Ok(2), ValueChanged},
+		{Ok(1), Err[int](errVal), OkToErr},
+		{Err[int](errVal), Ok(1), ErrToOk},
+		{Err[int](errVal), Err[int](errors.New("other")), Unchanged},
+	}
+	for _, c := range cases {
+		if got := Diff(c.old, c.new, eq); got != c.want {
+			t.Errorf("Diff(%v, %v) = %v, want %v", c.old, c.new, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package result
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+)
+
+// ========================== gob 与二进制编码 =============================
+
+var ErrInvalidEncoding = errors.New("invalid Result encoding")
+
+// 实现 gob.GobEncoder：首字节表示 Ok 或 Err，Ok 时其后为值的 gob 编码，Err 时其后为错误消息。
+// 错误只保留消息，解码后无法再使用 errors.Is/As 匹配原错误
+func (r Result[T]) GobEncode() ([]byte, error) {
+	if r.IsErr() {
+		return append([]byte{0}, r.err.Error()...), nil
+	}
+	buf := bytes.NewBuffer([]byte{1})
+	if err := gob.NewEncoder(buf).Encode(&r.val); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// 实现 gob.GobDecoder，Err 解码为以原消息构造的错误
+func (r *Result[T]) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidEncoding
+	}
+	if data[0] == 0 {
+		*r = Err[T](errors.New(string(data[1:])))
+		return nil
+	}
+	var v T
+	if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&v); err != nil {
+		return err
+	}
+	*r = Ok(v)
+	return nil
+}
+
+// 实现 encoding.BinaryMarshaler，格式与 GobEncode 相同
+func (r Result[T]) MarshalBinary() ([]byte, error) {
+	return r.GobEncode()
+}
+
+// 实现 encoding.BinaryUnmarshaler
+func (r *Result[T]) UnmarshalBinary(data []byte) error {
+	return r.GobDecode(data)
+}
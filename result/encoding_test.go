@@ -0,0 +1,23 @@
+package result
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+func TestGob(t *testing.T) {
+	in := []Result[int]{Ok(0), Err[int](errors.New("fail"))}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out []Result[int]
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != 2 || !out[0].Has(0) || out[1].IsOk() || out[1].GetErr().Error() != "fail" {
+		t.Errorf("Unexpected round trip: %v", out)
+	}
+}
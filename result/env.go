@@ -0,0 +1,28 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/viocha/go-option/internal/common"
+)
+
+// ========================== 环境变量 =============================
+
+var ErrEnvNotSet = errors.New("environment variable not set")
+
+// 读取环境变量并解析为 T，规则与 option.Flag 相同。
+// 未设置时返回 ErrEnvNotSet，可以使用 Val() 将未设置和解析失败都视为 Nul
+func EnvAs[T any](name string) Result[T] {
+	s, ok := os.LookupEnv(name)
+	if !ok {
+		return Err[T](fmt.Errorf("%w: %s", ErrEnvNotSet, name))
+	}
+	v, err := common.ParseString(s, reflect.TypeFor[T]())
+	if err != nil {
+		return Err[T](fmt.Errorf("parse %s: %w", name, err))
+	}
+	return Ok(v.Interface().(T))
+}
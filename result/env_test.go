@@ -0,0 +1,17 @@
+package result
+
+import "testing"
+
+func TestEnvAs(t *testing.T) {
+	t.Setenv("RESULT_TEST_PORT", "8080")
+	t.Setenv("RESULT_TEST_BAD", "x")
+	if r := EnvAs[int]("RESULT_TEST_PORT"); !r.Has(8080) {
+		t.Errorf("Expected Ok(8080), got %v", r)
+	}
+	if r := EnvAs[int]("RESULT_TEST_BAD"); r.IsOk() || r.HasErr(ErrEnvNotSet) {
+		t.Errorf("Expected a parse error, got %v", r)
+	}
+	if r := EnvAs[int]("RESULT_TEST_UNSET"); !r.HasErr(ErrEnvNotSet) {
+		t.Errorf("Expected ErrEnvNotSet, got %v", r)
+	}
+}
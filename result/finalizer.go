@@ -0,0 +1,70 @@
+package result
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/viocha/go-option/internal/common"
+	"github.com/viocha/go-option/internal/must"
+)
+
+// =========================== 终结函数 ============================
+
+// 在 WithFinalizers 的函数体结束时按注册的逆序调用的终结函数集合，可以安全地并发使用
+type Finalizers[T any] struct {
+	mu    sync.Mutex
+	fns   []func(Result[T])
+	done  bool
+	final Result[T]
+}
+
+// 注册一个终结函数，它会接收函数体的最终结果，且只被调用一次。
+// 函数体已经结束（例如保存了 Finalizers 并在之后继续使用）时立即以最终结果调用 f，此时 f 中的 panic 不会被捕获
+func (fs *Finalizers[T]) Defer(f func(Result[T])) {
+	fs.mu.Lock()
+	if !fs.done {
+		fs.fns = append(fs.fns, f)
+		fs.mu.Unlock()
+		return
+	}
+	final := fs.final
+	fs.mu.Unlock()
+	f(final)
+}
+
+// 执行 body，在其返回（或因 ErrMust panic 而结束）后按注册的逆序调用所有终结函数。
+// 终结函数中的 ErrMust panic 会被捕获，其错误与 body 的错误合并后返回。
+// body 中发生其他 panic 时，终结函数同样会以包含该 panic 的 Err 被调用，之后 panic 继续传播
+func WithFinalizers[T any](body func(fs *Finalizers[T]) Result[T]) Result[T] {
+	fs := &Finalizers[T]{}
+	var r Result[T]
+	common.OnAbort(func() {
+		r = callSource(func() Result[T] { return body(fs) })
+	}, func(err error) {
+		// panic 会继续传播，合并后的结果不再使用
+		_ = fs.finish(Err[T](err))
+	})
+	return fs.finish(r)
+}
+
+// 以最终结果 r 按注册的逆序调用所有终结函数，返回与终结函数的错误合并后的结果
+func (fs *Finalizers[T]) finish(r Result[T]) Result[T] {
+	fs.mu.Lock()
+	fns := fs.fns
+	fs.fns, fs.done, fs.final = nil, true, r
+	fs.mu.Unlock()
+
+	var errs []error
+	for i := len(fns) - 1; i >= 0; i-- {
+		if err := must.CatchMustPanic(func() { fns[i](r) }); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return r
+	}
+	if r.IsErr() {
+		errs = append([]error{r.err}, errs...)
+	}
+	return Err[T](errors.Join(errs...))
+}
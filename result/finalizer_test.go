@@ -0,0 +1,66 @@
+package result
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/viocha/go-option/util"
+)
+
+func TestWithFinalizers(t *testing.T) {
+	var log []string
+	errFail := errors.New("fail")
+	var saved *Finalizers[int]
+	r := WithFinalizers(func(fs *Finalizers[int]) Result[int] {
+		saved = fs
+		fs.Defer(func(r Result[int]) { log = append(log, "close a: "+r.String()) })
+		return Then(Ok(1), func(v int) Result[int] {
+			fs.Defer(func(r Result[int]) { log = append(log, "close b") })
+			return Err[int](errFail)
+		}).Else(func(error) Result[int] { return Ok(2) })
+	})
+	if !r.Has(2) {
+		t.Errorf("Expected Ok(2), got %v", r)
+	}
+	want := []string{"close b", "close a: " + Ok(2).String()}
+	if !slices.Equal(log, want) {
+		t.Errorf("Expected finalizers in reverse order with the final result, got %v", log)
+	}
+
+	saved.Defer(func(r Result[int]) { log = append(log, "late") })
+	if len(log) != 3 || log[2] != "late" {
+		t.Errorf("Expected late finalizers to run immediately, got %v", log)
+	}
+}
+
+func TestWithFinalizers_Errors(t *testing.T) {
+	errBody, errClose := errors.New("body"), errors.New("close")
+	r := WithFinalizers(func(fs *Finalizers[int]) Result[int] {
+		fs.Defer(func(Result[int]) { util.MustNil(errClose) })
+		util.MustNil(errBody)
+		return Ok(1)
+	})
+	if !r.HasErr(errBody) || !r.HasErr(errClose) {
+		t.Errorf("Expected body and finalizer errors to be joined, got %v", r)
+	}
+}
+
+func TestWithFinalizers_Panic(t *testing.T) {
+	errBoom := errors.New("boom")
+	var got Result[int]
+	func() {
+		defer func() {
+			if v := recover(); v != errBoom {
+				t.Errorf("Expected the panic to propagate, got %v", v)
+			}
+		}()
+		WithFinalizers(func(fs *Finalizers[int]) Result[int] {
+			fs.Defer(func(r Result[int]) { got = r })
+			panic(errBoom)
+		})
+	}()
+	if !got.HasErr(errBoom) {
+		t.Errorf("Expected the finalizer to run with the panic as Err, got %v", got)
+	}
+}
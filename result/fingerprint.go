@@ -0,0 +1,77 @@
+package result
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	opt "github.com/viocha/go-option"
+)
+
+// =========================== 错误指纹 ============================
+
+// 实现该接口的错误带有错误码，错误码会参与指纹计算，也可以通过 Result.Code 读取
+type coder interface {
+	Code() string
+}
+
+// 为 Err 计算稳定的指纹，Ok 时返回 Nul。
+// 指纹由错误链中每个错误的类型和错误码决定，忽略消息中的易变内容；
+// 只有叶子节点的 errors.New 哨兵错误会将消息计入指纹
+func Fingerprint[T any](r Result[T]) opt.Option[string] {
+	if r.IsOk() {
+		return opt.Nul[string]()
+	}
+	var sb strings.Builder
+	writeFingerprint(&sb, r.err)
+	sum := sha256.Sum256([]byte(sb.String()))
+	return opt.Val(hex.EncodeToString(sum[:8]))
+}
+
+func writeFingerprint(sb *strings.Builder, err error) {
+	if m, ok := err.(*metaError); ok { // 元数据不参与指纹计算
+		writeFingerprint(sb, m.err)
+		return
+	}
+	sb.WriteString(reflect.TypeOf(err).String())
+	if c, ok := err.(coder); ok {
+		fmt.Fprintf(sb, "#%s", c.Code())
+	}
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := e.Unwrap(); inner != nil {
+			sb.WriteString("(")
+			writeFingerprint(sb, inner)
+			sb.WriteString(")")
+		}
+	case interface{ Unwrap() []error }:
+		sb.WriteString("[")
+		for i, inner := range e.Unwrap() {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			writeFingerprint(sb, inner)
+		}
+		sb.WriteString("]")
+	default:
+		if reflect.TypeOf(err) == sentinelType {
+			fmt.Fprintf(sb, "%q", err.Error())
+		}
+	}
+}
+
+var sentinelType = reflect.TypeOf(errors.New(""))
+
+// 按指纹对所有 Err 分组，Ok 会被忽略
+func GroupByFingerprint[T any](rs []Result[T]) map[string][]Result[T] {
+	groups := make(map[string][]Result[T])
+	for _, r := range rs {
+		Fingerprint(r).Try(func(fp string) {
+			groups[fp] = append(groups[fp], r)
+		})
+	}
+	return groups
+}
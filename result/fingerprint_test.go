@@ -0,0 +1,58 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type codedErr struct {
+	code string
+	msg  string
+}
+
+func (e codedErr) Error() string { return e.msg }
+func (e codedErr) Code() string  { return e.code }
+
+func TestFingerprint(t *testing.T) {
+	if Fingerprint(Ok(1)).IsVal() {
+		t.Error("Expected no fingerprint for Ok")
+	}
+
+	errNotFound := errors.New("not found")
+	fp1 := Fingerprint(Err[int](fmt.Errorf("user %d: %w", 1, errNotFound)))
+	fp2 := Fingerprint(Err[int](fmt.Errorf("user %d: %w", 2, errNotFound)))
+	if !fp1.IsVal() || fp1.Get() != fp2.Get() {
+		t.Errorf("Expected equal fingerprints ignoring volatile details, got %v and %v", fp1, fp2)
+	}
+
+	fp3 := Fingerprint(Err[int](fmt.Errorf("user %d: %w", 1, errors.New("forbidden"))))
+	if fp3.Get() == fp1.Get() {
+		t.Error("Expected different sentinels to produce different fingerprints")
+	}
+
+	c1 := Fingerprint(Err[int](codedErr{"E1", "a"}))
+	c2 := Fingerprint(Err[int](codedErr{"E1", "b"}))
+	c3 := Fingerprint(Err[int](codedErr{"E2", "a"}))
+	if c1.Get() != c2.Get() || c1.Get() == c3.Get() {
+		t.Errorf("Expected fingerprints to depend on codes only, got %v %v %v", c1, c2, c3)
+	}
+}
+
+func TestGroupByFingerprint(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	groups := GroupByFingerprint([]Result[int]{
+		Ok(1),
+		Err[int](fmt.Errorf("x: %w", errA)),
+		Err[int](fmt.Errorf("y: %w", errA)),
+		Err[int](errB),
+	})
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d", len(groups))
+	}
+	fp := Fingerprint(Err[int](fmt.Errorf("z: %w", errA))).Get()
+	if len(groups[fp]) != 2 {
+		t.Errorf("Expected 2 results in group, got %d", len(groups[fp]))
+	}
+}
@@ -0,0 +1,48 @@
+package result
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/viocha/go-option/util"
+)
+
+func TestHooks(t *testing.T) {
+	var panics, errs []util.HookEvent
+	util.SetPanicHook(func(e util.HookEvent) { panics = append(panics, e) })
+	util.SetErrHook(func(e util.HookEvent) { errs = append(errs, e) })
+	defer util.SetPanicHook(nil)
+	defer util.SetErrHook(nil)
+
+	errFail := errors.New("fail")
+	Err[string](errFail)
+	FromFunc(func() int { util.MustNil(errFail); return 0 })
+
+	if len(panics) != 1 || !errors.Is(panics[0].Err, errFail) || len(panics[0].Stack) == 0 {
+		t.Errorf("Expected one panic event, got %v", panics)
+	}
+	if len(errs) != 2 || errs[0].Type != reflect.TypeFor[string]() || errs[1].Type != reflect.TypeFor[int]() {
+		t.Errorf("Expected Err events with value types, got %v", errs)
+	}
+	if prev := util.SetErrHook(nil); prev == nil {
+		t.Error("Expected SetErrHook to return the previous hook")
+	}
+	Err[int](errFail)
+	if len(errs) != 2 {
+		t.Errorf("Expected no events after removing the hook, got %d", len(errs))
+	}
+}
+
+func TestHooks_Propagation(t *testing.T) {
+	var errs []util.HookEvent
+	util.SetErrHook(func(e util.HookEvent) { errs = append(errs, e) })
+	defer util.SetErrHook(nil)
+
+	r := Then(Map(Err[int](errors.New("fail")), func(v int) int { return v + 1 }), func(v int) Result[string] { return Ok("x") })
+	r = r.Wrap("load").MapErr(func(err error) error { return err })
+	Collect([]Result[string]{r})
+	if len(errs) != 1 {
+		t.Errorf("Expected the hook to fire once for a propagated error, got %d", len(errs))
+	}
+}
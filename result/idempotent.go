@@ -0,0 +1,107 @@
+package result
+
+import (
+	"sync"
+	"time"
+)
+
+// =========================== 幂等执行 ============================
+
+// Idempotent 使用的存储，ttl 为 0 表示永不过期
+type KV interface {
+	Load(key string) (any, bool)
+	Store(key string, val any, ttl time.Duration)
+}
+
+// 同一进程内相同 key 的并发调用只会执行一次
+var inflight = struct {
+	sync.Mutex
+	locks map[string]*keyLock
+}{locks: make(map[string]*keyLock)}
+
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+func lockKey(key string) func() {
+	inflight.Lock()
+	l, ok := inflight.locks[key]
+	if !ok {
+		l = &keyLock{}
+		inflight.locks[key] = l
+	}
+	l.refs++
+	inflight.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		inflight.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(inflight.locks, key)
+		}
+		inflight.Unlock()
+	}
+}
+
+// 如果 store 中已保存 key 对应的结果，则直接返回该结果，否则执行 f 并保存其结果
+func Idempotent[T any](store KV, key string, f func() Result[T]) Result[T] {
+	return IdempotentTTL(store, key, 0, f)
+}
+
+// 同 Idempotent，保存的结果在 ttl 后过期
+func IdempotentTTL[T any](store KV, key string, ttl time.Duration, f func() Result[T]) Result[T] {
+	unlock := lockKey(key)
+	defer unlock()
+
+	if v, ok := store.Load(key); ok {
+		if r, ok := v.(Result[T]); ok {
+			return r
+		}
+	}
+	r := callSource(f)
+	store.Store(key, r, ttl)
+	return r
+}
+
+// 基于内存的 KV 实现，可安全地并发使用
+type MemKV struct {
+	mu   sync.Mutex
+	data map[string]memEntry
+	now  func() time.Time
+}
+
+type memEntry struct {
+	val      any
+	expireAt time.Time
+}
+
+func NewMemKV() *MemKV {
+	return &MemKV{data: make(map[string]memEntry), now: time.Now}
+}
+
+func (m *MemKV) Load(key string) (any, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.data[key]
+	if !ok {
+		return nil, false
+	}
+	if !e.expireAt.IsZero() && !m.now().Before(e.expireAt) {
+		delete(m.data, key)
+		return nil, false
+	}
+	return e.val, true
+}
+
+func (m *MemKV) Store(key string, val any, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e := memEntry{val: val}
+	if ttl > 0 {
+		e.expireAt = m.now().Add(ttl)
+	}
+	m.data[key] = e
+}
@@ -0,0 +1,67 @@
+package result
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIdempotent(t *testing.T) {
+	store := NewMemKV()
+	calls := 0
+	f := func() Result[int] {
+		calls++
+		return Ok(calls)
+	}
+	if r := Idempotent(store, "k", f); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	if r := Idempotent(store, "k", f); !r.Has(1) || calls != 1 {
+		t.Errorf("Expected stored Ok(1) without re-executing, got %v with %d calls", r, calls)
+	}
+
+	errVal := errors.New("declined")
+	Idempotent(store, "e", func() Result[int] { return Err[int](errVal) })
+	if r := Idempotent(store, "e", f); !r.HasErr(errVal) {
+		t.Errorf("Expected stored Err to be returned, got %v", r)
+	}
+}
+
+func TestIdempotentTTL(t *testing.T) {
+	now := time.Now()
+	store := NewMemKV()
+	store.now = func() time.Time { return now }
+	calls := 0
+	f := func() Result[int] {
+		calls++
+		return Ok(calls)
+	}
+	IdempotentTTL(store, "k", time.Minute, f)
+	now = now.Add(2 * time.Minute)
+	if r := IdempotentTTL(store, "k", time.Minute, f); !r.Has(2) {
+		t.Errorf("Expected re-execution after TTL, got %v", r)
+	}
+}
+
+func TestIdempotent_Concurrent(t *testing.T) {
+	store := NewMemKV()
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Idempotent(store, "k", func() Result[int] {
+				calls.Add(1)
+				time.Sleep(time.Millisecond)
+				return Ok(1)
+			})
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("Expected f to execute once, got %d", calls.Load())
+	}
+}
@@ -0,0 +1,73 @@
+package result
+
+import (
+	"strings"
+
+	opt "github.com/viocha/go-option"
+)
+
+// =========================== 批量错误 ============================
+
+// 批量处理中第 Index 个元素产生的错误
+type IndexedError struct {
+	Index int
+	Err   error
+}
+
+func (e IndexedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e IndexedError) Unwrap() error {
+	return e.Err
+}
+
+// 按元素顺序排列的批量错误，由 CollectAll、ParallelMap 等函数返回。
+// 错误消息与 errors.Join 相同，可以通过 errors.As 取出后查询具体是哪些元素失败了
+type IndexedErrors []IndexedError
+
+func (es IndexedErrors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (es IndexedErrors) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+	return errs
+}
+
+// 返回第 i 个元素的错误，该元素没有失败时返回 Nul
+func (es IndexedErrors) ByIndex(i int) opt.Option[error] {
+	for _, e := range es {
+		if e.Index == i {
+			return opt.Val(e.Err)
+		}
+	}
+	return opt.Nul[error]()
+}
+
+// 返回所有失败元素的下标
+func (es IndexedErrors) Indices() []int {
+	indices := make([]int, len(es))
+	for i, e := range es {
+		indices[i] = e.Index
+	}
+	return indices
+}
+
+// 收集 rs 中所有 Err 的错误及其下标，没有 Err 时返回 nil
+func indexedErrs[T any](rs []Result[T]) IndexedErrors {
+	var es IndexedErrors
+	for i, r := range rs {
+		if r.IsErr() {
+			es = append(es, IndexedError{Index: i, Err: r.err})
+		}
+	}
+	return es
+}
@@ -0,0 +1,34 @@
+package result
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestIndexedErrors(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	r := CollectAll([]Result[int]{Ok(1), Err[int](err1), Ok(3), Err[int](err2)})
+
+	var es IndexedErrors
+	if !r.HasErrAs(&es) {
+		t.Fatalf("Expected IndexedErrors, got %v", r)
+	}
+	if !slices.Equal(es.Indices(), []int{1, 3}) {
+		t.Errorf("Expected indices [1 3], got %v", es.Indices())
+	}
+	if e := es.ByIndex(3); !e.Has(err2) {
+		t.Errorf("Expected err2 at index 3, got %v", e)
+	}
+	if e := es.ByIndex(0); e.IsVal() {
+		t.Errorf("Expected no error at index 0, got %v", e)
+	}
+	if !r.HasErr(err1) || r.GetErr().Error() != "err1\nerr2" {
+		t.Errorf("Expected errors.Join compatible behavior, got %q", r.GetErr())
+	}
+	var ie IndexedError
+	if !errors.As(r.GetErr(), &ie) || ie.Index != 1 {
+		t.Errorf("Expected the first IndexedError at index 1, got %+v", ie)
+	}
+}
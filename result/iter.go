@@ -0,0 +1,68 @@
+package result
+
+import "iter"
+
+// =========================== 迭代器 ============================
+
+// 返回一个迭代器，Ok 时产出其值，否则不产出任何元素
+func (r Result[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if r.IsOk() {
+			yield(r.Get())
+		}
+	}
+}
+
+// 取迭代器的第一个元素，迭代器为空时返回 Err(err)
+func FromSeq[T any](seq iter.Seq[T], err error) Result[T] {
+	for v := range seq {
+		return Ok(v)
+	}
+	return Err[T](err)
+}
+
+// 对序列中每个 Result 执行 Map
+func MapSeq[T, U any](seq iter.Seq[Result[T]], f func(T) U) iter.Seq[Result[U]] {
+	return func(yield func(Result[U]) bool) {
+		for r := range seq {
+			if !yield(Map(r, f)) {
+				return
+			}
+		}
+	}
+}
+
+// 对序列中每个 Ok 的值执行f，只产出f返回的 Ok 的值，丢弃所有 Err
+func FilterMapSeq[T, U any](seq iter.Seq[Result[T]], f func(T) Result[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for r := range seq {
+			u := Then(r, f)
+			if u.IsOk() && !yield(u.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// 依次产出序列中的元素，产出第一个 Err 后停止
+func TakeUntilErr[T any](seq iter.Seq[Result[T]]) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for r := range seq {
+			if !yield(r) || r.IsErr() {
+				return
+			}
+		}
+	}
+}
+
+// 将序列收集为 Result[[]T]，遇到第一个 Err 时停止并返回该 Err
+func CollectSeq[T any](seq iter.Seq[Result[T]]) Result[[]T] {
+	var vals []T
+	for r := range seq {
+		if r.IsErr() {
+			return Propagate[[]T](r.err)
+		}
+		vals = append(vals, r.Get())
+	}
+	return Ok(vals)
+}
@@ -0,0 +1,53 @@
+package result
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestIter(t *testing.T) {
+	if vals := slices.Collect(Ok(1).Iter()); !slices.Equal(vals, []int{1}) {
+		t.Errorf("Expected [1], got %v", vals)
+	}
+	if vals := slices.Collect(Err[int](errors.New("err")).Iter()); len(vals) != 0 {
+		t.Errorf("Expected [], got %v", vals)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	errEmpty := errors.New("empty")
+	if r := FromSeq(slices.Values([]string{"a", "b"}), errEmpty); !r.Has("a") {
+		t.Errorf("Expected Ok(a), got %v", r)
+	}
+	if r := FromSeq(slices.Values([]string{}), errEmpty); !r.HasErr(errEmpty) {
+		t.Errorf("Expected Err(empty), got %v", r)
+	}
+}
+
+func TestSeqAdapters(t *testing.T) {
+	errVal := errors.New("err")
+	rs := []Result[int]{Ok(1), Err[int](errVal), Ok(3)}
+
+	mapped := slices.Collect(MapSeq(slices.Values(rs), func(v int) int { return v * 10 }))
+	if len(mapped) != 3 || !mapped[0].Has(10) || !mapped[1].HasErr(errVal) || !mapped[2].Has(30) {
+		t.Errorf("Unexpected MapSeq output: %v", mapped)
+	}
+
+	vals := slices.Collect(FilterMapSeq(slices.Values(rs), func(v int) Result[int] { return Ok(v + 1) }))
+	if !slices.Equal(vals, []int{2, 4}) {
+		t.Errorf("Expected [2 4], got %v", vals)
+	}
+
+	taken := slices.Collect(TakeUntilErr(slices.Values(rs)))
+	if len(taken) != 2 || !taken[1].HasErr(errVal) {
+		t.Errorf("Expected to stop after the first error, got %v", taken)
+	}
+
+	if r := CollectSeq(slices.Values(rs)); !r.HasErr(errVal) {
+		t.Errorf("Expected Err, got %v", r)
+	}
+	if r := CollectSeq(slices.Values(rs[:1])); !r.IsOk() || !slices.Equal(r.Get(), []int{1}) {
+		t.Errorf("Expected Ok([1]), got %v", r)
+	}
+}
@@ -0,0 +1,97 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/viocha/go-option/internal/common"
+)
+
+// =========================== 限流 ============================
+
+var ErrRateLimited = errors.New("rate limited")
+
+// 非阻塞的限流器，*rate.Limiter（golang.org/x/time/rate）可以直接使用
+type Allower interface {
+	Allow() bool
+}
+
+// 阻塞等待的限流器，*rate.Limiter（golang.org/x/time/rate）可以直接使用
+type Waiter interface {
+	Wait(ctx context.Context) error
+}
+
+// 包装 f：被 l 限流时不调用 f，直接返回 ErrRateLimited
+func Limit[T any](l Allower, f func(context.Context) Result[T]) func(context.Context) Result[T] {
+	return func(ctx context.Context) Result[T] {
+		if !l.Allow() {
+			return Err[T](ErrRateLimited)
+		}
+		return callSource(func() Result[T] { return f(ctx) })
+	}
+}
+
+// 包装 f：调用前通过 l 等待，等待失败（如 ctx 被取消或等待时间超过截止时间）时返回包装了原因的 ErrRateLimited
+func LimitWait[T any](l Waiter, f func(context.Context) Result[T]) func(context.Context) Result[T] {
+	return func(ctx context.Context) Result[T] {
+		if err := l.Wait(ctx); err != nil {
+			return Err[T](fmt.Errorf("%w: %w", ErrRateLimited, err))
+		}
+		return callSource(func() Result[T] { return f(ctx) })
+	}
+}
+
+// 包装 f：在 d 内没有新的调用后才以最后一次调用的 ctx 执行一次 f，期间的所有调用都等待并得到同一个结果。
+// 某个调用的 ctx 在 f 执行前被取消时，该调用返回 Err(context.Cause(ctx))，不影响其他调用；
+// 如果它是最后一次调用，f 仍会以该 ctx 执行。f 中的任何 panic 都会被转换为包含 *util.PanicError 的 Err
+func Debounce[T any](d time.Duration, f func(context.Context) Result[T]) func(context.Context) Result[T] {
+	var (
+		mu      sync.Mutex
+		timer   *time.Timer
+		gen     int
+		lastCtx context.Context
+		waiters []chan Result[T]
+	)
+	fire := func(g int) {
+		mu.Lock()
+		if g != gen {
+			mu.Unlock()
+			return
+		}
+		ws, ctx := waiters, lastCtx
+		waiters, timer = nil, nil
+		mu.Unlock()
+
+		// f 在定时器的 goroutine 中执行，其中的任何 panic 都转换为 Err 交给等待的调用，而不是使进程崩溃
+		var r Result[T]
+		if err := common.SafeDo(func() { r = callSource(func() Result[T] { return f(ctx) }) }); err != nil {
+			r = Err[T](err)
+		}
+		for _, w := range ws {
+			w <- r
+		}
+	}
+	return func(ctx context.Context) Result[T] {
+		ch := make(chan Result[T], 1)
+		mu.Lock()
+		if timer != nil {
+			timer.Stop()
+		}
+		gen++
+		g := gen
+		timer = time.AfterFunc(d, func() { fire(g) })
+		lastCtx = ctx
+		waiters = append(waiters, ch)
+		mu.Unlock()
+
+		select {
+		case r := <-ch:
+			return r
+		case <-ctx.Done():
+			return Err[T](context.Cause(ctx))
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type tokenBucket struct{ tokens int }
+
+func (b *tokenBucket) Allow() bool {
+	if b.tokens == 0 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	if !b.Allow() {
+		return errors.New("would exceed context deadline")
+	}
+	return ctx.Err()
+}
+
+func TestLimit(t *testing.T) {
+	calls := 0
+	f := Limit(&tokenBucket{tokens: 1}, func(context.Context) Result[int] {
+		calls++
+		return Ok(calls)
+	})
+	if r := f(context.Background()); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	if r := f(context.Background()); !r.HasErr(ErrRateLimited) || calls != 1 {
+		t.Errorf("Expected ErrRateLimited without calling f, got %v after %d calls", r, calls)
+	}
+}
+
+func TestLimitWait(t *testing.T) {
+	f := LimitWait(&tokenBucket{tokens: 1}, func(context.Context) Result[string] {
+		return Ok("done")
+	})
+	if r := f(context.Background()); !r.Has("done") {
+		t.Errorf("Expected Ok(done), got %v", r)
+	}
+	if r := f(context.Background()); !r.HasErr(ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited, got %v", r)
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	var calls atomic.Int32
+	f := Debounce(20*time.Millisecond, func(ctx context.Context) Result[string] {
+		calls.Add(1)
+		name, _ := ctx.Value(keyName{}).(string)
+		return Ok(name)
+	})
+
+	var wg sync.WaitGroup
+	results := make([]Result[string], 3)
+	for i, name := range []string{"a", "b", "c"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = f(context.WithValue(context.Background(), keyName{}, name))
+		}()
+		time.Sleep(5 * time.Millisecond)
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single call, got %d", calls.Load())
+	}
+	for _, r := range results {
+		if !r.Has("c") {
+			t.Errorf("Expected every caller to get the last call's result, got %v", r)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if r := f(ctx); !r.HasErr(context.Canceled) {
+		t.Errorf("Expected a canceled caller to return early, got %v", r)
+	}
+}
+
+type keyName struct{}
+
+func TestDebounce_Panic(t *testing.T) {
+	errBoom := errors.New("boom")
+	f := Debounce(time.Millisecond, func(context.Context) Result[int] { panic(errBoom) })
+	if r := f(context.Background()); !r.HasErr(errBoom) {
+		t.Errorf("Expected the panic to be returned as Err, got %v", r)
+	}
+}
@@ -0,0 +1,110 @@
+package result
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/viocha/go-option/clock"
+	"github.com/viocha/go-option/internal/common"
+)
+
+// =========================== 记忆化 ============================
+
+type memoConfig struct {
+	cacheErrs  bool
+	errTTL     time.Duration
+	maxEntries int
+	clock      clock.Clock
+}
+
+type MemoOption func(*memoConfig)
+
+// 缓存 Err 结果，ttl 为 0 时永不过期。默认不缓存 Err，下一次调用会重新执行
+func CacheErrs(ttl time.Duration) MemoOption {
+	return func(c *memoConfig) { c.cacheErrs, c.errTTL = true, ttl }
+}
+
+// 最多缓存 n 个结果，超出时淘汰最久未使用的结果。默认不限制
+func MaxEntries(n int) MemoOption {
+	return func(c *memoConfig) { c.maxEntries = n }
+}
+
+// 计算 Err 过期时间使用的时间来源，默认为 clock.Real
+func MemoClock(clk clock.Clock) MemoOption {
+	return func(c *memoConfig) { c.clock = clk }
+}
+
+type memoEntry[K comparable, V any] struct {
+	key      K
+	done     chan struct{}
+	res      Result[V]
+	expireAt time.Time
+	elem     *list.Element
+}
+
+// 返回 f 的记忆化版本，可以安全地并发使用：Ok 结果会被缓存，Err 结果的缓存策略由 CacheErrs 决定。
+// 相同 key 的并发调用只会执行一次 f，f 中的 ErrMust panic 会被转换为 Err。
+// 其他 panic 会继续传播，同时等待中的调用得到包含该 panic 的 Err，该结果不会被缓存
+func Memoize[K comparable, V any](f func(K) Result[V], opts ...MemoOption) func(K) Result[V] {
+	cfg := memoConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	clk := clock.OrReal(cfg.clock)
+
+	var mu sync.Mutex
+	entries := make(map[K]*memoEntry[K, V])
+	lru := list.New()
+	remove := func(e *memoEntry[K, V]) {
+		if entries[e.key] == e {
+			delete(entries, e.key)
+			lru.Remove(e.elem)
+		}
+	}
+
+	return func(k K) Result[V] {
+		mu.Lock()
+		if e, ok := entries[k]; ok {
+			if e.expireAt.IsZero() || clk.Now().Before(e.expireAt) {
+				lru.MoveToFront(e.elem)
+				mu.Unlock()
+				<-e.done
+				return e.res
+			}
+			remove(e)
+		}
+		e := &memoEntry[K, V]{key: k, done: make(chan struct{})}
+		e.elem = lru.PushFront(e)
+		entries[k] = e
+		if cfg.maxEntries > 0 && lru.Len() > cfg.maxEntries {
+			remove(lru.Back().Value.(*memoEntry[K, V]))
+		}
+		mu.Unlock()
+
+		var r Result[V]
+		common.OnAbort(func() {
+			r = callSource(func() Result[V] { return f(k) })
+		}, func(err error) {
+			// f 中的其他 panic 继续传播，等待中的调用得到 Err，之后的调用重新执行 f
+			mu.Lock()
+			e.res = Err[V](err)
+			remove(e)
+			close(e.done)
+			mu.Unlock()
+		})
+
+		mu.Lock()
+		e.res = r
+		if r.IsErr() {
+			if !cfg.cacheErrs {
+				remove(e)
+			} else if cfg.errTTL > 0 {
+				e.expireAt = clk.Now().Add(cfg.errTTL)
+			}
+		}
+		close(e.done)
+		mu.Unlock()
+		return r
+	}
+}
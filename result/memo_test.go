@@ -0,0 +1,145 @@
+package result
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/viocha/go-option/clock/clocktest"
+)
+
+func TestMemoize(t *testing.T) {
+	var calls atomic.Int32
+	errOdd := errors.New("odd")
+	f := Memoize(func(k int) Result[int] {
+		calls.Add(1)
+		if k%2 != 0 {
+			return Err[int](errOdd)
+		}
+		return Ok(k * 10)
+	})
+
+	if r := f(2); !r.Has(20) {
+		t.Errorf("Expected Ok(20), got %v", r)
+	}
+	f(2)
+	if calls.Load() != 1 {
+		t.Errorf("Expected Ok to be cached, got %d calls", calls.Load())
+	}
+	f(1)
+	f(1)
+	if calls.Load() != 3 {
+		t.Errorf("Expected Err not to be cached by default, got %d calls", calls.Load())
+	}
+}
+
+func TestMemoize_CacheErrs(t *testing.T) {
+	clk := clocktest.NewFake(time.Unix(0, 0))
+	var calls int
+	f := Memoize(func(k string) Result[int] {
+		calls++
+		return Err[int](errors.New("down"))
+	}, CacheErrs(time.Minute), MemoClock(clk))
+
+	f("a")
+	f("a")
+	if calls != 1 {
+		t.Errorf("Expected Err to be cached, got %d calls", calls)
+	}
+	clk.Advance(time.Minute)
+	f("a")
+	if calls != 2 {
+		t.Errorf("Expected Err to expire after the TTL, got %d calls", calls)
+	}
+}
+
+func TestMemoize_MaxEntries(t *testing.T) {
+	var calls int
+	f := Memoize(func(k int) Result[int] {
+		calls++
+		return Ok(k)
+	}, MaxEntries(2))
+
+	f(1)
+	f(2)
+	f(1) // 1 成为最近使用的
+	f(3) // 淘汰 2
+	f(1)
+	if calls != 3 {
+		t.Errorf("Expected 1 to stay cached, got %d calls", calls)
+	}
+	f(2)
+	if calls != 4 {
+		t.Errorf("Expected 2 to be evicted, got %d calls", calls)
+	}
+}
+
+func TestMemoize_Concurrent(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	f := Memoize(func(k int) Result[int] {
+		calls.Add(1)
+		<-release
+		return Ok(k)
+	})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if r := f(7); !r.Has(7) {
+				t.Errorf("Expected Ok(7), got %v", r)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single call for concurrent callers, got %d", calls.Load())
+	}
+}
+
+func TestMemoize_Panic(t *testing.T) {
+	errBoom := errors.New("boom")
+	var calls atomic.Int32
+	release := make(chan struct{})
+	f := Memoize(func(k int) Result[int] {
+		if calls.Add(1) == 1 {
+			<-release
+			panic(errBoom)
+		}
+		return Ok(k)
+	})
+
+	panicked := make(chan any)
+	go func() {
+		defer func() { panicked <- recover() }()
+		f(1)
+	}()
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	waiter := make(chan Result[int])
+	go func() { waiter <- f(1) }()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+
+	if v := <-panicked; v != errBoom {
+		t.Errorf("Expected the panic to propagate, got %v", v)
+	}
+	select {
+	case r := <-waiter:
+		if !r.HasErr(errBoom) && !r.Has(1) {
+			t.Errorf("Expected the waiter to get Err(boom) or a fresh Ok(1), got %v", r)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the waiter not to block forever")
+	}
+	if r := f(1); !r.Has(1) {
+		t.Errorf("Expected the key to be recomputed after the panic, got %v", r)
+	}
+}
@@ -0,0 +1,84 @@
+package result
+
+import (
+	"fmt"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/internal/common"
+)
+
+// =========================== 错误元数据 ============================
+
+// 附加在错误上的一项元数据，对 errors.Is/As 透明；除非由 WithField 附加，也不改变错误消息
+type metaError struct {
+	err   error
+	key   string
+	value any
+	field bool // 是否在错误消息中显示
+}
+
+func (e *metaError) Error() string {
+	if !e.field {
+		return e.err.Error()
+	}
+	var v any = e.value
+	if common.IsRedacted(e.value) {
+		v = common.RedactedText
+	}
+	return fmt.Sprintf("%s [%s=%v]", e.err.Error(), e.key, v)
+}
+
+func (e *metaError) Unwrap() error {
+	return e.err
+}
+
+// Err 时在错误上附加一项元数据（如请求 ID、重试次数、上游状态码），Ok 时原样返回。
+// 同一个键被多次设置时，Meta 返回最后一次设置的值
+func (r Result[T]) WithMeta(key string, value any) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Propagate[T](&metaError{err: r.err, key: key, value: value})
+}
+
+// 与 WithMeta 相同，但键值对会以 "[key=value]" 的形式追加到错误消息中，被标记为敏感类型的值显示为 [REDACTED]
+func (r Result[T]) WithField(key string, value any) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Propagate[T](&metaError{err: r.err, key: key, value: value, field: true})
+}
+
+// 返回错误链中键为 key 的元数据，Ok 或不存在该键时返回 Nul
+func (r Result[T]) Meta(key string) opt.Option[any] {
+	return metaOf(r.err, key)
+}
+
+func metaOf(err error, key string) opt.Option[any] {
+	found := opt.Nul[any]()
+	common.WalkErr(err, func(err error, _ int) {
+		if m, ok := err.(*metaError); ok && m.key == key && found.IsNul() {
+			found = opt.Val(m.value)
+		}
+	})
+	return found
+}
+
+// 将 from 的错误链中的元数据附加到 to 上，to 的错误链中已存在的键不会被覆盖
+func carryMeta(from, to error) error {
+	var metas []*metaError
+	seen := make(map[string]bool)
+	common.WalkErr(from, func(err error, _ int) {
+		if m, ok := err.(*metaError); ok && !seen[m.key] && metaOf(to, m.key).IsNul() {
+			seen[m.key] = true
+			metas = append(metas, m)
+		}
+	})
+	// 逆序附加，使原来位于外层的元数据仍然位于外层
+	for i := len(metas) - 1; i >= 0; i-- {
+		m := *metas[i]
+		m.err = to
+		to = &m
+	}
+	return to
+}
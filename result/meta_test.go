@@ -0,0 +1,39 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestWithMeta(t *testing.T) {
+	errUpstream := errors.New("upstream failed")
+	r := Err[int](errUpstream).WithMeta("request_id", "abc").WithMeta("status", 502)
+
+	if got := r.Meta("request_id"); !got.Has("abc") {
+		t.Errorf("Expected request_id abc, got %v", got)
+	}
+	if got := r.Meta("missing"); got.IsVal() {
+		t.Errorf("Expected None for a missing key, got %v", got)
+	}
+	if !r.HasErr(errUpstream) || r.GetErr().Error() != "upstream failed" {
+		t.Errorf("Expected metadata to be transparent, got %v", r)
+	}
+	if Ok(1).WithMeta("k", 1).Meta("k").IsVal() {
+		t.Error("Expected Ok to carry no metadata")
+	}
+	if Fingerprint(r) != Fingerprint(Err[int](errUpstream)) {
+		t.Error("Expected metadata not to change the fingerprint")
+	}
+}
+
+func TestWithMeta_MapErr(t *testing.T) {
+	r := Err[int](errors.New("raw")).WithMeta("attempt", 1).WithMeta("attempt", 2)
+	mapped := r.MapErr(func(err error) error { return fmt.Errorf("replaced") })
+	if got := mapped.Meta("attempt"); !got.Has(2) {
+		t.Errorf("Expected the latest attempt to survive MapErr, got %v", got)
+	}
+	if mapped.GetErr().Error() != "replaced" {
+		t.Errorf("Expected the mapped error, got %v", mapped.GetErr())
+	}
+}
@@ -0,0 +1,139 @@
+package result
+
+import (
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/viocha/go-option/internal/must"
+)
+
+// 统计失败率时保留的最近调用次数
+const multiSourceWindow = 20
+
+var ErrNoSource = errors.New("multi source has no provider")
+
+// 一个具名的 Result 提供者
+type Source[T any] struct {
+	Name string
+	Fn   func() Result[T]
+}
+
+// 单个提供者的统计信息
+type SourceStats struct {
+	Name        string
+	Calls       int     // 累计调用次数
+	Failures    int     // 累计失败次数
+	FailureRate float64 // 最近窗口内的失败率
+}
+
+type sourceState[T any] struct {
+	Source[T]
+	calls    int
+	failures int
+	recent   []bool // 最近的调用结果，true 表示失败
+}
+
+func (s *sourceState[T]) record(failed bool) {
+	s.calls++
+	if failed {
+		s.failures++
+	}
+	s.recent = append(s.recent, failed)
+	if len(s.recent) > multiSourceWindow {
+		s.recent = s.recent[1:]
+	}
+}
+
+func (s *sourceState[T]) failureRate() float64 {
+	if len(s.recent) == 0 {
+		return 0
+	}
+	n := 0
+	for _, failed := range s.recent {
+		if failed {
+			n++
+		}
+	}
+	return float64(n) / float64(len(s.recent))
+}
+
+// 包装多个提供者，按最近失败率从低到高依次尝试，返回第一个 Ok
+type MultiSource[T any] struct {
+	mu      sync.Mutex
+	sources []*sourceState[T]
+}
+
+func NewMultiSource[T any](sources ...Source[T]) *MultiSource[T] {
+	m := &MultiSource[T]{}
+	for _, s := range sources {
+		m.sources = append(m.sources, &sourceState[T]{Source: s})
+	}
+	return m
+}
+
+// 按健康程度依次调用提供者，返回第一个 Ok。全部失败时返回合并后的错误
+func (m *MultiSource[T]) Get() Result[T] {
+	if len(m.sources) == 0 {
+		return Err[T](ErrNoSource)
+	}
+	var errs []error
+	for _, s := range m.ordered() {
+		r := callSource(s.Fn)
+		m.mu.Lock()
+		s.record(r.IsErr())
+		m.mu.Unlock()
+		if r.IsOk() {
+			return r
+		}
+		errs = append(errs, r.err)
+	}
+	return Propagate[T](errors.Join(errs...))
+}
+
+// 返回各提供者的统计信息，顺序与注册顺序一致
+func (m *MultiSource[T]) Stats() []SourceStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats := make([]SourceStats, 0, len(m.sources))
+	for _, s := range m.sources {
+		stats = append(stats, SourceStats{
+			Name:        s.Name,
+			Calls:       s.calls,
+			Failures:    s.failures,
+			FailureRate: s.failureRate(),
+		})
+	}
+	return stats
+}
+
+// 按失败率从低到高排序，失败率相同时保持注册顺序
+func (m *MultiSource[T]) ordered() []*sourceState[T] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sources := slices.Clone(m.sources)
+	rates := make(map[*sourceState[T]]float64, len(sources))
+	for _, s := range sources {
+		rates[s] = s.failureRate()
+	}
+	slices.SortStableFunc(sources, func(a, b *sourceState[T]) int {
+		switch {
+		case rates[a] < rates[b]:
+			return -1
+		case rates[a] > rates[b]:
+			return 1
+		}
+		return 0
+	})
+	return sources
+}
+
+func callSource[T any](f func() Result[T]) Result[T] {
+	var r Result[T]
+	if err := must.CatchMustPanic(func() {
+		r = f()
+	}); err != nil {
+		return Err[T](err)
+	}
+	return r
+}
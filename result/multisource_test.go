@@ -0,0 +1,57 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMultiSource(t *testing.T) {
+	errDown := errors.New("down")
+	primaryCalls := 0
+	m := NewMultiSource(
+		Source[string]{Name: "primary", Fn: func() Result[string] {
+			primaryCalls++
+			return Err[string](errDown)
+		}},
+		Source[string]{Name: "backup", Fn: func() Result[string] { return Ok("backup") }},
+	)
+
+	r := m.Get()
+	if !r.Has("backup") {
+		t.Errorf("Expected Ok(backup), got %v", r)
+	}
+	if primaryCalls != 1 {
+		t.Errorf("Expected primary to be tried first, got %d calls", primaryCalls)
+	}
+
+	// primary 失败率更高，之后应优先调用 backup
+	r = m.Get()
+	if !r.Has("backup") || primaryCalls != 1 {
+		t.Errorf("Expected backup to be routed first, got %v with %d primary calls", r, primaryCalls)
+	}
+
+	stats := m.Stats()
+	if stats[0].Name != "primary" || stats[0].Failures != 1 || stats[0].FailureRate != 1 {
+		t.Errorf("Unexpected primary stats: %+v", stats[0])
+	}
+	if stats[1].Calls != 2 || stats[1].FailureRate != 0 {
+		t.Errorf("Unexpected backup stats: %+v", stats[1])
+	}
+}
+
+func TestMultiSource_AllFail(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	m := NewMultiSource(
+		Source[int]{Name: "a", Fn: func() Result[int] { return Err[int](err1) }},
+		Source[int]{Name: "b", Fn: func() Result[int] { return Err[int](err2) }},
+	)
+	r := m.Get()
+	if !r.HasErr(err1) || !r.HasErr(err2) {
+		t.Errorf("Expected joined error, got %v", r)
+	}
+
+	if r := NewMultiSource[int]().Get(); !r.HasErr(ErrNoSource) {
+		t.Errorf("Expected ErrNoSource, got %v", r)
+	}
+}
@@ -0,0 +1,46 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+
+	opt "github.com/viocha/go-option"
+)
+
+// =========================== 数值 ============================
+
+// 是否为 Ok 且值与 v 之差不超过 epsilon
+func HasNear[T opt.Float](r Result[T], v T, epsilon float64) bool {
+	return r.HasWith(v, opt.Near[T](epsilon))
+}
+
+// =========================== 数值转换 ============================
+
+var ErrLossyConversion = errors.New("lossy numeric conversion") // 数值转换溢出或丢失精度
+
+// 将 o 中的值转换为 To 类型：Nul 时返回 Ok(Nul)，转换会溢出、改变符号或丢失精度（包括浮点数的小数部分、NaN 和 Inf）时
+// 返回包装了 ErrLossyConversion 的 Err。To 在前以便只指定目标类型，例如 Convert[int32](o)
+func Convert[To, From opt.Number](o opt.Option[From]) Result[opt.Option[To]] {
+	if o.IsNul() {
+		return Ok(opt.Nul[To]())
+	}
+	v, err := convertNumber[To](o.Get())
+	if err != nil {
+		return Err[opt.Option[To]](err)
+	}
+	return Ok(opt.Val(v))
+}
+
+// 将浮点数转换为 int，等价于 Convert[int]
+func ToIntChecked[F opt.Float](o opt.Option[F]) Result[opt.Option[int]] {
+	return Convert[int](o)
+}
+
+// 转换后能够原样转换回来且符号不变时视为无损
+func convertNumber[To, From opt.Number](v From) (To, error) {
+	out := To(v)
+	if From(out) != v || (v < 0) != (out < 0) {
+		return 0, fmt.Errorf("%w: %v (%T) to %T", ErrLossyConversion, v, v, out)
+	}
+	return out, nil
+}
@@ -0,0 +1,54 @@
+package result
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	opt "github.com/viocha/go-option"
+)
+
+func TestHasNear(t *testing.T) {
+	a, b := 0.1, 0.2
+	if !HasNear(Ok(a+b), 0.3, 1e-9) {
+		t.Error("Expected Ok(0.1+0.2) to be near 0.3")
+	}
+	if HasNear(Err[float64](errors.New("err")), 0, 1) {
+		t.Error("Expected HasNear on Err to return false")
+	}
+}
+
+func TestConvert(t *testing.T) {
+	if r := Convert[int32](opt.Val(int64(42))); !r.IsOk() || !r.Get().Has(42) {
+		t.Errorf("Expected Ok(Val(42)), got %v", r)
+	}
+	if r := Convert[int32](opt.Nul[int64]()); !r.IsOk() || r.Get().IsVal() {
+		t.Errorf("Expected Ok(Nul), got %v", r)
+	}
+	for _, r := range []Result[opt.Option[int32]]{
+		Convert[int32](opt.Val(int64(math.MaxInt32 + 1))),
+		Convert[int32](opt.Val(uint32(math.MaxUint32))),
+		Convert[int32](opt.Val(2.5)),
+	} {
+		if !r.HasErr(ErrLossyConversion) {
+			t.Errorf("Expected ErrLossyConversion, got %v", r)
+		}
+	}
+	if r := Convert[uint8](opt.Val(int8(-1))); !r.HasErr(ErrLossyConversion) {
+		t.Errorf("Expected sign change to be rejected, got %v", r)
+	}
+	if r := Convert[float64](opt.Val(float32(1.5))); !r.IsOk() || !r.Get().Has(1.5) {
+		t.Errorf("Expected Ok(Val(1.5)), got %v", r)
+	}
+}
+
+func TestToIntChecked(t *testing.T) {
+	if r := ToIntChecked(opt.Val(3.0)); !r.IsOk() || !r.Get().Has(3) {
+		t.Errorf("Expected Ok(Val(3)), got %v", r)
+	}
+	for _, f := range []float64{3.7, math.NaN(), math.Inf(1), 1e300} {
+		if r := ToIntChecked(opt.Val(f)); !r.HasErr(ErrLossyConversion) {
+			t.Errorf("Expected ErrLossyConversion for %v, got %v", f, r)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package result
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// =========================== 并行映射 ============================
+
+type parallelConfig struct {
+	workers    int
+	collectAll bool
+}
+
+type ParallelOption func(*parallelConfig)
+
+// 并发执行的 worker 数量，默认为 runtime.GOMAXPROCS(0)
+func Workers(n int) ParallelOption {
+	return func(c *parallelConfig) { c.workers = n }
+}
+
+// 某个元素失败时继续处理其余元素，最终返回按元素顺序排列的 IndexedErrors
+func CollectAllErrs() ParallelOption {
+	return func(c *parallelConfig) { c.collectAll = true }
+}
+
+// 并行地对每个元素调用 f，全部为 Ok 时按 items 的顺序返回所有值。
+// 默认在第一个 Err 出现后不再处理新的元素并返回该错误，f 中的 ErrMust panic 会被转换为 Err
+func ParallelMap[T, U any](items []T, f func(T) Result[U], opts ...ParallelOption) Result[[]U] {
+	cfg := parallelConfig{workers: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	results := make([]Result[U], len(items))
+	var next atomic.Int64
+	var failed atomic.Bool
+	var firstErr error
+	var once sync.Once
+	var wg sync.WaitGroup
+	for range min(max(cfg.workers, 1), len(items)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(items) || (!cfg.collectAll && failed.Load()) {
+					return
+				}
+				results[i] = callSource(func() Result[U] { return f(items[i]) })
+				if results[i].IsErr() {
+					once.Do(func() { firstErr = results[i].err })
+					failed.Store(true)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if !failed.Load() {
+		vals := make([]U, len(items))
+		for i, r := range results {
+			vals[i] = r.val
+		}
+		return Ok(vals)
+	}
+	if !cfg.collectAll {
+		return Propagate[[]U](firstErr)
+	}
+	return Propagate[[]U](indexedErrs(results))
+}
@@ -0,0 +1,43 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallelMap(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	r := ParallelMap(items, func(n int) Result[int] { return Ok(n * n) }, Workers(3))
+	if !r.Has([]int{1, 4, 9, 16, 25, 36, 49, 64}) {
+		t.Errorf("Expected squares in order, got %v", r)
+	}
+	if r := ParallelMap(nil, func(n int) Result[int] { return Ok(n) }); !r.Has([]int{}) {
+		t.Errorf("Expected an empty slice, got %v", r)
+	}
+}
+
+func TestParallelMap_FailFast(t *testing.T) {
+	errBad := errors.New("bad item")
+	var calls atomic.Int32
+	r := ParallelMap(make([]int, 100), func(int) Result[int] {
+		calls.Add(1)
+		return Err[int](errBad)
+	}, Workers(1))
+	if !r.HasErr(errBad) || calls.Load() != 1 {
+		t.Errorf("Expected to stop after the first error, got %v after %d calls", r, calls.Load())
+	}
+}
+
+func TestParallelMap_CollectAllErrs(t *testing.T) {
+	r := ParallelMap([]int{1, 2, 3, 4}, func(n int) Result[int] {
+		if n%2 == 0 {
+			return Err[int](fmt.Errorf("item %d", n))
+		}
+		return Ok(n)
+	}, CollectAllErrs(), Workers(2))
+	if r.IsOk() || r.GetErr().Error() != "item 2\nitem 4" {
+		t.Errorf("Expected both errors in item order, got %v", r)
+	}
+}
@@ -0,0 +1,49 @@
+package result
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+
+	"github.com/viocha/go-option/internal/quickval"
+)
+
+// ========================== 随机生成 =============================
+
+// 实现 testing/quick.Generator：以相同的概率生成 Ok 或 Err，值按 testing/quick.Value 的规则生成，
+// 错误为 RandomErr；T 无法随机生成时总是返回 Err
+func (Result[T]) Generate(r *rand.Rand, size int) reflect.Value {
+	if r.Intn(2) == 0 {
+		return reflect.ValueOf(Err[T](RandomErr(r)))
+	}
+	v, ok := quickval.Value(reflect.TypeFor[T](), r, size)
+	if !ok {
+		return reflect.ValueOf(Err[T](RandomErr(r)))
+	}
+	return reflect.ValueOf(Ok(v.Interface().(T)))
+}
+
+// 随机生成的错误，可以使用 errors.As 识别
+type GeneratedError struct {
+	N int
+}
+
+func (e *GeneratedError) Error() string {
+	return fmt.Sprintf("generated error #%d", e.N)
+}
+
+// 返回一个随机的 *GeneratedError
+func RandomErr(r *rand.Rand) error {
+	return &GeneratedError{N: r.Intn(1000)}
+}
+
+// 由值和错误的生成函数构造 Result 的生成函数，以相同的概率生成 Ok(gen(r)) 或 Err(errGen(r))，
+// 可以用于 testing/quick.Config.Values 中
+func Generator[T any](gen func(r *rand.Rand) T, errGen func(r *rand.Rand) error) func(r *rand.Rand) Result[T] {
+	return func(r *rand.Rand) Result[T] {
+		if r.Intn(2) == 0 {
+			return Err[T](errGen(r))
+		}
+		return Ok(gen(r))
+	}
+}
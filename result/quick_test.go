@@ -0,0 +1,40 @@
+package result
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	opt "github.com/viocha/go-option"
+)
+
+func TestGenerate(t *testing.T) {
+	var oks, errs int
+	f := func(r Result[opt.Option[string]]) bool {
+		if r.IsOk() {
+			oks++
+			return true
+		}
+		errs++
+		var ge *GeneratedError
+		return errors.As(r.GetErr(), &ge)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 200}); err != nil {
+		t.Error(err)
+	}
+	if oks == 0 || errs == 0 {
+		t.Errorf("Expected both Ok and Err, got %d/%d", oks, errs)
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	errVal := errors.New("fail")
+	gen := Generator(func(r *rand.Rand) int { return r.Intn(10) }, func(*rand.Rand) error { return errVal })
+	r := rand.New(rand.NewSource(1))
+	for range 50 {
+		if res := gen(r); res.IsErr() && !res.HasErr(errVal) {
+			t.Errorf("Expected error from errGen, got %v", res)
+		}
+	}
+}
@@ -3,28 +3,75 @@ package result
 import (
 	"errors"
 	"fmt"
-	"reflect"
 	
 	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/internal/common"
+	"github.com/viocha/go-option/internal/hooks"
 	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/internal/stats"
+	"github.com/viocha/go-option/util"
 )
 
+// 值直接保存在结构体中，构造和读取都不需要额外的堆分配。
+// 零值 Result[T]{} 等价于 Ok(T 的零值)：不存在错误即为 Ok
 type Result[T any] struct {
-	val *T
+	val T
 	err error
 }
 
 // ========================== 构造函数 =============================
 
 func Ok[T any](value T) Result[T] {
-	return Result[T]{val: &value, err: nil}
+	stats.Inc(&stats.Oks)
+	stats.Site(false)
+	return Result[T]{val: value}
 }
 
 func Err[T any](err error) Result[T] {
 	if err == nil {
 		panic("Err() called with nil error")
 	}
-	return Result[T]{val: nil, err: err}
+	stats.Inc(&stats.Errs)
+	stats.Site(true)
+	hooks.FireErr[T](err)
+	return Result[T]{err: err}
+}
+
+// 构造转发已有错误的 Err，用于组合子将上游 Result 的错误（或由其包装、合并得到的错误）传给下游。
+// 与 Err 不同，不会调用 SetErrHook 设置的钩子，也不计入 metrics 的 Err 计数，因此一个错误经过多少步链式调用都只记录一次
+func Propagate[T any](err error) Result[T] {
+	if err == nil {
+		panic("Propagate() called with nil error")
+	}
+	return Result[T]{err: err}
+}
+
+// 返回包含哨兵错误的 Err，适合在缓存未命中等热路径中使用。
+// Result 直接保存错误接口值，构造 Err 不会产生堆分配，因此所有调用共享同一个哨兵错误而无需额外缓存
+func ErrCached[T any](sentinel error) Result[T] {
+	return Err[T](sentinel)
+}
+
+// 构造一个可重复使用的 Err，用于在包级变量中预先创建，之后直接返回该变量。
+// 与 Err 相同的薄封装，只用于表明意图；SetErrHook 设置的钩子和 Err 计数只在构造时发生一次，而不是每次返回时
+func PreallocErr[T any](err error) Result[T] {
+	return Err[T](err)
+}
+
+// cond 为 true 时返回 Ok(v)，否则返回 Err(err)
+func If[T any](cond bool, v T, err error) Result[T] {
+	if !cond {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// cond 为 true 时以 f 的返回值构造 Ok，否则不调用 f 并返回 Err(err)。与 FromFunc 一样捕获 ErrMust panic
+func IfFunc[T any](cond bool, f func() T, err error) Result[T] {
+	if !cond {
+		return Err[T](err)
+	}
+	return FromFunc(f)
 }
 
 // 将 T 和 error 转换为 Result[T]
@@ -43,6 +90,14 @@ func FromOption[T any](o opt.Option[T], err error) Result[T] {
 	return Err[T](err)
 }
 
+// 与 FromOption 相同，但只在 o 不存在值时才调用 errFn 构造错误
+func FromOptionFunc[T any](o opt.Option[T], errFn func() error) Result[T] {
+	if o.IsVal() {
+		return Ok[T](o.Get())
+	}
+	return Err[T](errFn())
+}
+
 func FromFunc[T any](f func() T) Result[T] {
 	var result Result[T]
 	if err := must.CatchMustPanic(func() {
@@ -53,21 +108,57 @@ func FromFunc[T any](f func() T) Result[T] {
 	return result
 }
 
+// 调用返回 (T, error) 的函数，返回的错误作为 Err。与 FromFunc 不同，不捕获 panic（包括 util.MustXxx 产生的），
+// 只有 f 显式返回的错误才会成为 Err
+func FromFuncErr[T any](f func() (T, error)) Result[T] {
+	return From(f())
+}
+
 // ========================== 方法 =============================
 
 func (r Result[T]) String() string {
 	if r.IsOk() {
-		return fmt.Sprintf("Ok[%T](%v)", r.Get(), r.Get())
+		return "Ok[" + common.TypeName(r.val) + "](" + fmt.Sprint(r.val) + ")"
+	}
+	return "Err[" + common.TypeName(r.val) + "](" + r.err.Error() + ")"
+}
+
+// 实现 fmt.Formatter：%#v 输出构造函数形式，Err 的 %+v 会输出完整的错误链，其余动词作用于内部的值
+func (r Result[T]) Format(f fmt.State, verb rune) {
+	name := common.TypeName(r.val)
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		if r.IsOk() {
+			fmt.Fprintf(f, "result.Ok[%s](%#v)", name, r.val)
+		} else {
+			fmt.Fprintf(f, "result.Err[%s](%q)", name, r.err.Error())
+		}
+	case r.IsOk():
+		fmt.Fprintf(f, "Ok[%s](", name)
+		common.FormatValue(f, verb, r.val)
+		fmt.Fprint(f, ")")
+	default:
+		fmt.Fprintf(f, "Err[%s](%v)", name, r.err)
+		if verb == 'v' && f.Flag('+') {
+			common.FormatErrChain(f, r.err)
+		}
 	}
-	typ := reflect.TypeFor[T]()
-	return fmt.Sprintf("Err[%v](%v)", typ, r.err)
 }
 
+// 克隆。值的类型实现了 Clone() T 时使用该方法深拷贝，否则为浅拷贝；错误不会被复制
 func (r Result[T]) Clone() Result[T] {
 	if r.IsOk() {
-		return Ok(r.Get())
+		return Ok(common.Clone(r.Get()))
 	}
-	return Err[T](r.err)
+	return Propagate[T](r.err)
+}
+
+// Ok 时使用 clone 复制其值
+func (r Result[T]) CloneFunc(clone func(T) T) Result[T] {
+	if r.IsOk() {
+		return Ok(clone(r.Get()))
+	}
+	return Propagate[T](r.err)
 }
 
 func (r Result[T]) IsOk() bool {
@@ -79,7 +170,12 @@ func (r Result[T]) IsErr() bool {
 }
 
 func (r Result[T]) Has(v T) bool {
-	return r.IsOk() && reflect.DeepEqual(r.Get(), v)
+	return r.IsOk() && common.Equal(r.Get(), v)
+}
+
+// 是否为 Ok 且值与 v 相等，使用 eq 进行比较
+func (r Result[T]) HasWith(v T, eq func(a, b T) bool) bool {
+	return r.IsOk() && eq(r.Get(), v)
 }
 
 func (r Result[T]) HasFunc(f func(T) bool) bool {
@@ -94,14 +190,30 @@ func (r Result[T]) HasErrFunc(f func(error) bool) bool {
 	return !r.IsOk() && f(r.err)
 }
 
+// 是否为 Err 且错误链中存在可以赋值给 target 的错误，参见 errors.As
+func (r Result[T]) HasErrAs(target any) bool {
+	return !r.IsOk() && errors.As(r.err, target)
+}
+
 // =========================== 获取值或错误 ============================
 
 // 如果 Result 是 Ok，则返回其包含的值。否则 panic
 func (r Result[T]) Get() T {
 	if !r.IsOk() {
-		panic(fmt.Sprintf("called Result.Unwrap() on an Err value: %v", r.err))
+		stats.Inc(&stats.FailedUnwraps)
+		panic(fmt.Sprintf("called Result.Get() on an Err value: %v", r.err))
 	}
-	return *r.val
+	return r.val
+}
+
+// 如果 Result 是 Ok，则返回其包含的值。否则以 msg 和错误消息组成的字符串 panic，不会被组合子捕获
+func (r Result[T]) Expect(msg string) T {
+	if !r.IsOk() {
+		stats.Inc(&stats.FailedUnwraps)
+		// 以字符串 panic，即使错误中包含 ErrMust 也不会被 Map/Then 等组合子当作 Must 失败捕获
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+	return r.val
 }
 
 func (r Result[T]) GetOr(v T) T { return r.Val().GetOr(v) }
@@ -118,21 +230,44 @@ func (r Result[T]) GetOrFunc(f func(error) T) T {
 // 如果 Result 是 Err，则返回其包含的错误。否则 panic
 func (r Result[T]) GetErr() error {
 	if r.IsOk() {
-		panic("called Result.UnwrapErr() on an Ok value")
+		stats.Inc(&stats.FailedUnwraps)
+		panic("called Result.GetErr() on an Ok value")
 	}
 	return r.err
 }
 
-func (r Result[T]) Unwrap() (T, error) {
+// 如果 Result 是 Err，则返回其包含的错误，否则返回 nil。
+// 可以直接传给 errors.Is/As 等接受 error 的函数，例如 errors.Is(r.ErrOrNil(), target)
+func (r Result[T]) ErrOrNil() error {
+	return r.err
+}
+
+// 如果 Result 是 Err，则返回其包含的错误。否则以 msg 和其中的值为消息 panic
+func (r Result[T]) ExpectErr(msg string) error {
+	if r.IsOk() {
+		stats.Inc(&stats.FailedUnwraps)
+		panic(fmt.Sprintf("%s: expected an error, got %v", msg, r))
+	}
+	return r.err
+}
+
+// 同时返回值和错误：Ok 时返回值和 nil，Err 时返回零值和错误
+func (r Result[T]) Unpack() (T, error) {
 	if r.IsOk() {
 		return r.Get(), nil
 	}
 	return *new(T), r.err
 }
 
+// Deprecated: 使用 Unpack
+func (r Result[T]) Unwrap() (T, error) {
+	return r.Unpack()
+}
+
+// 返回指向值的副本的指针，Err 时返回 nil
 func (r Result[T]) ToPtr() *T {
 	if r.IsOk() {
-		return r.val
+		return &r.val
 	}
 	return nil
 }
@@ -153,6 +288,15 @@ func (r Result[T]) Err() opt.Option[error] {
 	return opt.Nul[error]()
 }
 
+// 如果是 Err 且错误链中存在类型为 E 的错误，则返回该错误，否则返回 Nul
+func ErrAs[E error, T any](r Result[T]) opt.Option[E] {
+	var target E
+	if r.HasErrAs(&target) {
+		return opt.Val(target)
+	}
+	return opt.Nul[E]()
+}
+
 // ========================== 链式方法 ============================
 
 func (r Result[T]) Try(f func(T)) Result[T] {
@@ -179,6 +323,22 @@ func (r Result[T]) Catch(f func(error)) Result[T] {
 	return r
 }
 
+// Ok 时以其值调用 f 并原样返回 r。与 Try 不同，不捕获任何 panic，也不改变 r
+func (r Result[T]) Inspect(f func(T)) Result[T] {
+	if r.IsOk() {
+		f(r.val)
+	}
+	return r
+}
+
+// Err 时以其错误调用 f 并原样返回 r。与 Catch 不同，不捕获任何 panic，也不改变 r
+func (r Result[T]) InspectErr(f func(error)) Result[T] {
+	if r.IsErr() {
+		f(r.err)
+	}
+	return r
+}
+
 func (r Result[T]) Finally(f func()) Result[T] {
 	if err := must.CatchMustPanic(f); err != nil {
 		return Err[T](err)
@@ -213,28 +373,46 @@ func (r Result[T]) ElseMap(f func(error) T) Result[T] {
 	return newResult
 }
 
-// ========================== 常用类型的逻辑与方法 ============================
+// Err时使用f转换其错误，Ok时原样返回。原错误上的元数据（参见 WithMeta）会被保留
+func (r Result[T]) MapErr(f func(error) error) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	var newResult Result[T]
+	if err := must.CatchMustPanic(func() {
+		newResult = Propagate[T](carryMeta(r.err, f(r.err)))
+	}); err != nil {
+		return Err[T](err)
+	}
+	return newResult
+}
 
-func (r Result[T]) ThenT(f func(T) Result[T]) Result[T]                 { return Then(r, f) }
-func (r Result[T]) ThenInt(f func(T) Result[int]) Result[int]           { return Then(r, f) }
-func (r Result[T]) ThenFloat(f func(T) Result[float64]) Result[float64] { return Then(r, f) }
-func (r Result[T]) ThenStr(f func(T) Result[string]) Result[string]     { return Then(r, f) }
-func (r Result[T]) ThenBool(f func(T) Result[bool]) Result[bool]        { return Then(r, f) }
+// Ok 时断言其值满足 pred，不满足时返回包含 *util.AssertionError 的 Err。
+// 开启 util.SetDebugAssertions 时直接以 *util.AssertionError panic
+func (r Result[T]) Assert(pred func(T) bool, msg string) Result[T] {
+	if r.IsErr() || pred(r.Get()) {
+		return r
+	}
+	err := &util.AssertionError{Msg: msg, Value: r.Get()}
+	if util.DebugAssertions() {
+		panic(err)
+	}
+	return Err[T](err)
+}
 
-// ========================== 常用类型的Map方法 ============================
+// ========================== 同类型的链式方法 ============================
 
-func (r Result[T]) MapT(f func(T) T) Result[T]                 { return Map(r, f) }
-func (r Result[T]) MapInt(f func(T) int) Result[int]           { return Map(r, f) }
-func (r Result[T]) MapFloat(f func(T) float64) Result[float64] { return Map(r, f) }
-func (r Result[T]) MapStr(f func(T) string) Result[string]     { return Map(r, f) }
-func (r Result[T]) MapBool(f func(T) bool) Result[bool]        { return Map(r, f) }
+// 使用 Then/Map 函数可以转换为任意类型；需要固定目标类型的适配函数时，可以使用 cmd/optiongen 生成
+
+func (r Result[T]) ThenT(f func(T) Result[T]) Result[T] { return Then(r, f) }
+func (r Result[T]) MapT(f func(T) T) Result[T]           { return Map(r, f) }
 
 // ========================== 逻辑与 ============================
 
 // Ok时则调用f得到一个新的Result
 func Then[T any, U any](r Result[T], f func(T) Result[U]) Result[U] {
 	if r.IsErr() {
-		return Err[U](r.err)
+		return Propagate[U](r.err)
 	}
 	var newResult Result[U]
 	if err := must.CatchMustPanic(func() {
@@ -245,11 +423,84 @@ func Then[T any, U any](r Result[T], f func(T) Result[U]) Result[U] {
 	return newResult
 }
 
+// Ok 时调用返回 (U, error) 的函数，将其结果转换为 Result
+func ThenFrom[T any, U any](r Result[T], f func(T) (U, error)) Result[U] {
+	return Then(r, func(v T) Result[U] { return From(f(v)) })
+}
+
+// o 存在值时返回 f 的结果，否则返回 Err(missingErr)。相当于 option.ThenResult，由于循环导入而放在 result 包中
+func ThenResult[T any, U any](o opt.Option[T], f func(T) Result[U], missingErr error) Result[U] {
+	return Then(FromOption(o, missingErr), f)
+}
+
+// Ok 时调用返回 Option 的 f，f 返回 Nul 时转换为 Err(missingErr)
+func ThenOption[T any, U any](r Result[T], f func(T) opt.Option[U], missingErr error) Result[U] {
+	return Then(r, func(v T) Result[U] { return FromOption(f(v), missingErr) })
+}
+
+// 展开嵌套的 Result
+func Flatten[T any](r Result[Result[T]]) Result[T] {
+	if r.IsErr() {
+		return Propagate[T](r.err)
+	}
+	return r.Get()
+}
+
+// ========================== 逻辑或 ============================
+
+var ErrNoCandidates = errors.New("no candidates given")
+
+// 返回第一个 Ok，都为 Err 时返回所有错误的合并，没有任何参数时返回 ErrNoCandidates
+func FirstOk[T any](rs ...Result[T]) Result[T] {
+	if len(rs) == 0 {
+		return Err[T](ErrNoCandidates)
+	}
+	errs := make([]error, 0, len(rs))
+	for _, r := range rs {
+		if r.IsOk() {
+			return r
+		}
+		errs = append(errs, r.err)
+	}
+	return Propagate[T](errors.Join(errs...))
+}
+
+// 依次调用 fns，返回第一个 Ok，之后的函数不会被调用。都为 Err 时返回所有错误的合并，
+// 没有任何参数时返回 ErrNoCandidates，函数中的 ErrMust panic 视为 Err
+func FirstOkFunc[T any](fns ...func() Result[T]) Result[T] {
+	if len(fns) == 0 {
+		return Err[T](ErrNoCandidates)
+	}
+	errs := make([]error, 0, len(fns))
+	for _, f := range fns {
+		r := callSource(f)
+		if r.IsOk() {
+			return r
+		}
+		errs = append(errs, r.err)
+	}
+	return Propagate[T](errors.Join(errs...))
+}
+
+// 与 Then 相同，但 f 中的任意 panic 都被捕获为 Err（*util.PanicError），不受 util.SetPanicPolicy 影响
+func TryThen[T any, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.IsErr() {
+		return Propagate[U](r.err)
+	}
+	var newResult Result[U]
+	if err := must.CatchAnyPanic(func() {
+		newResult = f(r.Get())
+	}); err != nil {
+		return Err[U](err)
+	}
+	return newResult
+}
+
 // ==========================  Map操作 ============================
 // Ok时使用f转换其值，构造一个新的 Result
 func Map[T any, U any](r Result[T], f func(T) U) Result[U] {
 	if r.IsErr() {
-		return Err[U](r.err)
+		return Propagate[U](r.err)
 	}
 	var newResult Result[U]
 	if err := must.CatchMustPanic(func() {
@@ -260,6 +511,50 @@ func Map[T any, U any](r Result[T], f func(T) U) Result[U] {
 	return newResult
 }
 
+// 与 Map 相同，但 f 中的任意 panic 都被捕获为 Err（*util.PanicError），不受 util.SetPanicPolicy 影响
+func TryMap[T any, U any](r Result[T], f func(T) U) Result[U] {
+	return TryThen(r, func(v T) Result[U] { return Ok(f(v)) })
+}
+
+// 均为 Ok 时使用f组合其值，否则返回第一个 Err
+func Map2[A, B, U any](a Result[A], b Result[B], f func(A, B) U) Result[U] {
+	switch {
+	case a.IsErr():
+		return Propagate[U](a.err)
+	case b.IsErr():
+		return Propagate[U](b.err)
+	}
+	return FromFunc(func() U { return f(a.Get(), b.Get()) })
+}
+
+// 均为 Ok 时使用f组合其值，否则返回第一个 Err
+func Map3[A, B, C, U any](a Result[A], b Result[B], c Result[C], f func(A, B, C) U) Result[U] {
+	switch {
+	case a.IsErr():
+		return Propagate[U](a.err)
+	case b.IsErr():
+		return Propagate[U](b.err)
+	case c.IsErr():
+		return Propagate[U](c.err)
+	}
+	return FromFunc(func() U { return f(a.Get(), b.Get(), c.Get()) })
+}
+
+// 均为 Ok 时使用f组合其值，否则返回第一个 Err
+func Map4[A, B, C, D, U any](a Result[A], b Result[B], c Result[C], d Result[D], f func(A, B, C, D) U) Result[U] {
+	switch {
+	case a.IsErr():
+		return Propagate[U](a.err)
+	case b.IsErr():
+		return Propagate[U](b.err)
+	case c.IsErr():
+		return Propagate[U](c.err)
+	case d.IsErr():
+		return Propagate[U](d.err)
+	}
+	return FromFunc(func() U { return f(a.Get(), b.Get(), c.Get(), d.Get()) })
+}
+
 // ==========================  带有默认值的Map操作 ============================
 
 // Ok时则使用f转换其值并返回，否则返回默认值 v
@@ -290,4 +585,38 @@ func MapOrFunc[T any, U any](r Result[T], okFn func(T) U, errFn func(error) U) U
 	return val
 }
 
+// ========================== 模式匹配 ============================
+
+// Ok时返回 okFn 的结果，否则返回 errFn 的结果，不捕获任何 panic
+func Match[T any, U any](r Result[T], okFn func(T) U, errFn func(error) U) U {
+	if r.IsOk() {
+		return okFn(r.Get())
+	}
+	return errFn(r.err)
+}
+
+// Ok时调用 okFn，否则调用 errFn，不捕获任何 panic
+func MatchDo[T any](r Result[T], okFn func(T), errFn func(error)) {
+	if r.IsOk() {
+		okFn(r.Get())
+		return
+	}
+	errFn(r.err)
+}
+
+// 处理 Result 所有情况的访问者。将来增加新的情况时会向接口中添加方法，
+// 未实现新方法的访问者会在编译期报错，而不是像 Match 那样静默地落入某个分支
+type ResultVisitor[T any, U any] interface {
+	OkCase(v T) U
+	ErrCase(err error) U
+}
+
+// 根据 r 的情况调用 v 中对应的方法，不捕获任何 panic
+func Visit[T any, U any](r Result[T], v ResultVisitor[T, U]) U {
+	if r.IsOk() {
+		return v.OkCase(r.val)
+	}
+	return v.ErrCase(r.err)
+}
+
 // =========================== 工具函数 ============================
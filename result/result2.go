@@ -0,0 +1,110 @@
+package result
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// 错误类型为具体类型 E 的 Result
+type Result2[T any, E error] struct {
+	val   T
+	err   E
+	isErr bool
+}
+
+// ========================== 构造函数 =============================
+
+func Ok2[T any, E error](value T) Result2[T, E] {
+	return Result2[T, E]{val: value}
+}
+
+func Err2[T any, E error](err E) Result2[T, E] {
+	return Result2[T, E]{err: err, isErr: true}
+}
+
+// ========================== 方法 =============================
+
+func (r Result2[T, E]) String() string {
+	if r.IsOk() {
+		return fmt.Sprintf("Ok[%T](%v)", r.val, r.val)
+	}
+	return fmt.Sprintf("Err[%v, %v](%v)", reflect.TypeFor[T](), reflect.TypeFor[E](), r.err)
+}
+
+func (r Result2[T, E]) IsOk() bool {
+	return !r.isErr
+}
+
+func (r Result2[T, E]) IsErr() bool {
+	return r.isErr
+}
+
+// 如果是 Ok，则返回其包含的值。否则 panic
+func (r Result2[T, E]) Get() T {
+	if r.IsErr() {
+		panic(fmt.Sprintf("called Result2.Get() on an Err value: %v", r.err))
+	}
+	return r.val
+}
+
+func (r Result2[T, E]) GetOr(v T) T {
+	if r.IsOk() {
+		return r.val
+	}
+	return v
+}
+
+// 如果是 Err，则返回其包含的具体类型的错误。否则 panic
+func (r Result2[T, E]) GetErr() E {
+	if r.IsOk() {
+		panic("called Result2.GetErr() on an Ok value")
+	}
+	return r.err
+}
+
+// 同时返回值和错误：Ok 时返回值和 E 的零值，Err 时返回 T 的零值和错误
+func (r Result2[T, E]) Unpack() (T, E) {
+	if r.IsOk() {
+		return r.val, *new(E)
+	}
+	return *new(T), r.err
+}
+
+// Deprecated: 使用 Unpack
+func (r Result2[T, E]) Unwrap() (T, E) {
+	return r.Unpack()
+}
+
+// 转换为错误类型为 error 的 Result
+func (r Result2[T, E]) ToResult() Result[T] {
+	if r.IsOk() {
+		return Ok(r.val)
+	}
+	return Err[T](r.err)
+}
+
+// ========================== 函数 =============================
+
+// Ok时调用f得到一个新的 Result2
+func ThenE[T, U any, E error](r Result2[T, E], f func(T) Result2[U, E]) Result2[U, E] {
+	if r.IsErr() {
+		return Err2[U](r.err)
+	}
+	return f(r.val)
+}
+
+// Ok时使用f转换其值
+func MapE[T, U any, E error](r Result2[T, E], f func(T) U) Result2[U, E] {
+	if r.IsErr() {
+		return Err2[U](r.err)
+	}
+	return Ok2[U, E](f(r.val))
+}
+
+// Err时使用f将错误转换为另一种具体类型
+func MapErrE[T any, E, F error](r Result2[T, E], f func(E) F) Result2[T, F] {
+	if r.IsOk() {
+		return Ok2[T, F](r.val)
+	}
+	return Err2[T](f(r.err))
+}
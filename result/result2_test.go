@@ -0,0 +1,74 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type codeErr struct{ code int }
+
+func (e codeErr) Error() string { return fmt.Sprintf("code %d", e.code) }
+
+type wrappedErr struct{ inner codeErr }
+
+func (e wrappedErr) Error() string { return "wrapped " + e.inner.Error() }
+
+func TestResult2(t *testing.T) {
+	ok := Ok2[int, codeErr](1)
+	if !ok.IsOk() || ok.Get() != 1 {
+		t.Errorf("Expected Ok(1), got %v", ok)
+	}
+
+	r := Err2[int](codeErr{404})
+	if !r.IsErr() || r.GetErr().code != 404 {
+		t.Errorf("Expected Err(code 404), got %v", r)
+	}
+	if !strings.HasPrefix(r.String(), "Err[int, result.codeErr]") {
+		t.Errorf("Unexpected string representation: %s", r)
+	}
+
+	var target codeErr
+	if res := r.ToResult(); !errors.As(res.GetErr(), &target) || target.code != 404 {
+		t.Errorf("Expected ToResult to keep the typed error, got %v", res)
+	}
+}
+
+func TestResult2_Combinators(t *testing.T) {
+	r := ThenE(Ok2[int, codeErr](2), func(v int) Result2[string, codeErr] {
+		return Ok2[string, codeErr](fmt.Sprint(v))
+	})
+	if r.Get() != "2" {
+		t.Errorf("Expected Ok(2), got %v", r)
+	}
+
+	m := MapE(Err2[int](codeErr{500}), func(v int) string {
+		t.Error("MapE func called on Err")
+		return ""
+	})
+	if m.GetErr().code != 500 {
+		t.Errorf("Expected Err(code 500), got %v", m)
+	}
+
+	w := MapErrE(Err2[int](codeErr{400}), func(e codeErr) wrappedErr { return wrappedErr{e} })
+	if w.GetErr().inner.code != 400 {
+		t.Errorf("Expected wrapped code 400, got %v", w)
+	}
+}
+
+func TestResult2_Unpack(t *testing.T) {
+	for _, r := range []Result2[int, codeErr]{Ok2[int, codeErr](1), Err2[int](codeErr{404})} {
+		v, err := r.Unpack()
+		oldV, oldErr := r.Unwrap()
+		if v != oldV || err != oldErr {
+			t.Errorf("Expected Unwrap to match Unpack for %v", r)
+		}
+		if r.IsErr() && (v != 0 || err.code != 404) {
+			t.Errorf("Expected zero value and error on Err, got %v, %v", v, err)
+		}
+		if r.IsOk() && (v != 1 || err != (codeErr{})) {
+			t.Errorf("Expected value and zero error on Ok, got %v, %v", v, err)
+		}
+	}
+}
@@ -3,10 +3,14 @@ package result
 import (
 	"errors"
 	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	
 	"github.com/viocha/go-option"
+	"github.com/viocha/go-option/util"
 )
 
 func TestOkAndErr(t *testing.T) {
@@ -119,6 +123,20 @@ func TestGetValErr(t *testing.T) {
 	}
 }
 
+func TestUnpack(t *testing.T) {
+	errFail := errors.New("fail")
+	for _, r := range []Result[string]{Ok("abc"), Err[string](errFail)} {
+		v, err := r.Unpack()
+		oldV, oldErr := r.Unwrap()
+		if v != oldV || err != oldErr {
+			t.Errorf("Expected Unwrap to match Unpack for %v", r)
+		}
+		if r.IsErr() && (v != "" || err != errFail) {
+			t.Errorf("Expected zero value and error on Err, got %q, %v", v, err)
+		}
+	}
+}
+
 func TestMap_Result(t *testing.T) {
 	r := Ok(2)
 	mapped := Map(r, func(x int) string { return fmt.Sprintf("%d!", x) })
@@ -199,6 +217,18 @@ func TestFromOpt_Result(t *testing.T) {
 	}
 }
 
+func TestFromOptionFunc(t *testing.T) {
+	errConv := errors.New("conversion error")
+	calls := 0
+	errFn := func() error { calls++; return errConv }
+	if r := FromOptionFunc(option.Val(5), errFn); !r.Has(5) || calls != 0 {
+		t.Errorf("Expected Ok(5) without calling errFn, got %v (%d calls)", r, calls)
+	}
+	if r := FromOptionFunc(option.Nul[int](), errFn); !r.HasErr(errConv) || calls != 1 {
+		t.Errorf("Expected Err(conversion error), got %v (%d calls)", r, calls)
+	}
+}
+
 func TestString_Result(t *testing.T) {
 	okStr := Ok(123).String()
 	if !strings.HasPrefix(okStr, "Ok[int]") || !strings.Contains(okStr, "123") {
@@ -340,3 +370,376 @@ func TestMapOrFunc_Result(t *testing.T) {
 		t.Errorf("Expected MapOrFunc on Err to use errFn, got %s, want %s", valErr, expectedErrStr)
 	}
 }
+
+func TestMapN_Result(t *testing.T) {
+	sum := Map2(Ok(1), Ok(2), func(a, b int) int { return a + b })
+	if !sum.Has(3) {
+		t.Errorf("Expected Map2 to return Ok(3), got %v", sum)
+	}
+
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	r := Map3(Ok(1), Err[int](err1), Err[int](err2), func(a, b, c int) int {
+		t.Error("Map3 func called with an Err input")
+		return 0
+	})
+	if !r.HasErr(err1) || r.HasErr(err2) {
+		t.Errorf("Expected Map3 to return the first error, got %v", r)
+	}
+
+	r4 := Map4(Ok(1), Ok(2), Ok(3), Ok(4), func(a, b, c, d int) int { return a * b * c * d })
+	if !r4.Has(24) {
+		t.Errorf("Expected Map4 to return Ok(24), got %v", r4)
+	}
+}
+
+func TestFlatten_Result(t *testing.T) {
+	if r := Flatten(Ok(Ok(1))); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	inner := errors.New("inner")
+	if r := Flatten(Ok(Err[int](inner))); !r.HasErr(inner) {
+		t.Errorf("Expected inner error, got %v", r)
+	}
+	outer := errors.New("outer")
+	if r := Flatten(Err[Result[int]](outer)); !r.HasErr(outer) {
+		t.Errorf("Expected outer error, got %v", r)
+	}
+}
+
+func TestMatch_Result(t *testing.T) {
+	ok := Match(Ok(2), func(v int) string { return fmt.Sprint(v) }, func(e error) string { return e.Error() })
+	if ok != "2" {
+		t.Errorf("Expected 2, got %s", ok)
+	}
+	errRes := Match(Err[int](errors.New("boom")), func(v int) string { return "ok" }, func(e error) string { return e.Error() })
+	if errRes != "boom" {
+		t.Errorf("Expected boom, got %s", errRes)
+	}
+
+	called := ""
+	MatchDo(Ok(1), func(int) { called = "ok" }, func(error) { called = "err" })
+	if called != "ok" {
+		t.Errorf("Expected okFn to be called, got %q", called)
+	}
+}
+
+type notFoundErr struct{ key string }
+
+func (e *notFoundErr) Error() string { return "not found: " + e.key }
+
+func TestErrAs(t *testing.T) {
+	r := Err[int](fmt.Errorf("lookup: %w", &notFoundErr{key: "a"}))
+	var target *notFoundErr
+	if !r.HasErrAs(&target) || target.key != "a" {
+		t.Errorf("Expected HasErrAs to find notFoundErr, got %v", target)
+	}
+	if e := ErrAs[*notFoundErr](r); !e.IsVal() || e.Get().key != "a" {
+		t.Errorf("Expected Some(notFoundErr), got %v", e)
+	}
+
+	if e := ErrAs[*notFoundErr](Err[int](errors.New("other"))); e.IsVal() {
+		t.Errorf("Expected None for unrelated error, got %v", e)
+	}
+	if e := ErrAs[*notFoundErr](Ok(1)); e.IsVal() {
+		t.Errorf("Expected None for Ok, got %v", e)
+	}
+}
+
+func TestPanicPolicy_Result(t *testing.T) {
+	errMust := errors.New("must fail")
+	mustFail := func(int) int { return util.MustGet(0, errMust) }
+	if r := Map(Ok(1), mustFail); !r.HasErr(errMust) {
+		t.Errorf("Expected captured panic to produce Err, got %v", r)
+	}
+
+	old := util.SetPanicPolicy(util.PropagatePanics)
+	defer util.SetPanicPolicy(old)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected panic to propagate under PropagatePanics")
+		}
+	}()
+	Map(Ok(1), mustFail)
+}
+
+func TestTryMap_Result(t *testing.T) {
+	old := util.SetPanicPolicy(util.PropagatePanics)
+	defer util.SetPanicPolicy(old)
+	var pe *util.PanicError
+	if r := TryMap(Ok(1), func(int) int { panic("boom") }); !r.HasErrAs(&pe) || pe.Value() != "boom" {
+		t.Errorf("Expected the panic to be captured as Err, got %v", r)
+	}
+	if r := TryThen(Ok(1), func(v int) Result[int] { return Ok(v + 1) }); !r.Has(2) {
+		t.Errorf("Expected Ok(2), got %v", r)
+	}
+}
+
+func TestPanicError_Result(t *testing.T) {
+	errMust := errors.New("must fail")
+	r := FromFunc(func() int { return util.MustGet(0, errMust) })
+	var pe *util.PanicError
+	if !r.HasErrAs(&pe) {
+		t.Fatalf("Expected PanicError in the error chain, got %v", r)
+	}
+	if !errors.Is(pe.Value().(error), errMust) {
+		t.Errorf("Expected panic value to wrap the original error, got %v", pe.Value())
+	}
+	if !strings.Contains(string(pe.Stack()), "TestPanicError_Result") {
+		t.Errorf("Expected stack to contain the panicking test, got %s", pe.Stack())
+	}
+	if !r.HasErr(errMust) || !r.HasErr(util.ErrMust) {
+		t.Errorf("Expected original errors to stay reachable, got %v", r)
+	}
+}
+
+func TestExpect_Result(t *testing.T) {
+	if v := Ok(1).Expect("load user"); v != 1 {
+		t.Errorf("Expected 1, got %d", v)
+	}
+	errVal := errors.New("db down")
+	if err := Err[int](errVal).ExpectErr("load user"); !errors.Is(err, errVal) {
+		t.Errorf("Expected db down, got %v", err)
+	}
+
+	defer func() {
+		r := recover()
+		if msg, ok := r.(string); !ok || msg != "load user: db down" {
+			t.Errorf("Expected panic with message and error, got %v", r)
+		}
+	}()
+	Err[int](errVal).Expect("load user")
+}
+
+func TestExpect_InsideMap(t *testing.T) {
+	inner := Err[int](util.WrapMust(errors.New("db down")))
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected Expect to panic through Map")
+		}
+	}()
+	r := Map(Ok(1), func(int) int { return inner.Expect("load user") })
+	t.Errorf("Expected a panic, got %v", r)
+}
+
+func TestExpectErr_Panic(t *testing.T) {
+	defer func() {
+		r := recover()
+		if msg, ok := r.(string); !ok || !strings.HasPrefix(msg, "want failure: ") {
+			t.Errorf("Expected panic message to start with the caller message, got %v", r)
+		}
+	}()
+	Ok(1).ExpectErr("want failure")
+}
+
+func TestFormat_Result(t *testing.T) {
+	errBase := errors.New("base")
+	r := Err[int](fmt.Errorf("outer: %w", errBase))
+	cases := []struct {
+		format string
+		res    Result[int]
+		want   string
+	}{
+		{"%v", Ok(1), "Ok[int](1)"},
+		{"%x", Ok(255), "Ok[int](ff)"},
+		{"%v", r, "Err[int](outer: base)"},
+		{"%#v", Ok(1), "result.Ok[int](1)"},
+		{"%#v", r, `result.Err[int]("outer: base")`},
+	}
+	for _, c := range cases {
+		if got := fmt.Sprintf(c.format, c.res); got != c.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", c.format, got, c.want)
+		}
+	}
+
+	chain := fmt.Sprintf("%+v", r)
+	if !strings.HasPrefix(chain, "Err[int](outer: base)\n") || !strings.Contains(chain, "-> *errors.errorString: base") {
+		t.Errorf("Expected %%+v to include the error chain, got %q", chain)
+	}
+}
+
+func TestFirstOk(t *testing.T) {
+	err1, err2 := errors.New("e1"), errors.New("e2")
+	if r := FirstOk(Err[int](err1), Ok(2), Ok(3)); !r.Has(2) {
+		t.Errorf("Expected Ok(2), got %v", r)
+	}
+	if r := FirstOk(Err[int](err1), Err[int](err2)); !r.HasErr(err1) || !r.HasErr(err2) {
+		t.Errorf("Expected both errors, got %v", r)
+	}
+	if r := FirstOk[int](); !r.HasErr(ErrNoCandidates) {
+		t.Errorf("Expected ErrNoCandidates, got %v", r)
+	}
+
+	calls := 0
+	r := FirstOkFunc(
+		func() Result[int] { calls++; return Err[int](err1) },
+		func() Result[int] { calls++; util.MustNil(err2); return Ok(0) },
+		func() Result[int] { calls++; return Ok(3) },
+		func() Result[int] { calls++; return Ok(4) },
+	)
+	if !r.Has(3) || calls != 3 {
+		t.Errorf("Expected Ok(3) after 3 calls, got %v after %d calls", r, calls)
+	}
+}
+
+func TestErrCached(t *testing.T) {
+	errNotFound := errors.New("not found")
+	if r := ErrCached[string](errNotFound); !r.HasErr(errNotFound) || r.GetErr() != ErrCached[string](errNotFound).GetErr() {
+		t.Errorf("Expected Err(not found), got %v", r)
+	}
+	if n := testing.AllocsPerRun(100, func() { _ = ErrCached[int](errNotFound) }); n != 0 {
+		t.Errorf("Expected no allocations, got %v", n)
+	}
+}
+
+var errPreallocNotFound = errors.New("not found")
+
+var notFound = PreallocErr[int](errPreallocNotFound)
+
+func TestPreallocErr(t *testing.T) {
+	lookup := func(ok bool) Result[int] {
+		if !ok {
+			return notFound
+		}
+		return Ok(1)
+	}
+	if r := lookup(false); !r.HasErr(errPreallocNotFound) {
+		t.Errorf("Expected Err(not found), got %v", r)
+	}
+	if n := testing.AllocsPerRun(100, func() { _ = lookup(false) }); n != 0 {
+		t.Errorf("Expected no allocations, got %v", n)
+	}
+}
+
+func TestAssert_Result(t *testing.T) {
+	nonEmpty := func(s string) bool { return s != "" }
+	if r := Ok("x").Assert(nonEmpty, "name is set"); !r.Has("x") {
+		t.Errorf("Expected Ok(x), got %v", r)
+	}
+	var assertErr *util.AssertionError
+	if r := Ok("").Assert(nonEmpty, "name is set"); !r.HasErrAs(&assertErr) || assertErr.Msg != "name is set" {
+		t.Errorf("Expected an AssertionError, got %v", r)
+	}
+
+	old := util.SetDebugAssertions(true)
+	defer util.SetDebugAssertions(old)
+	defer func() {
+		if _, ok := recover().(*util.AssertionError); !ok {
+			t.Error("Expected an AssertionError panic in debug mode")
+		}
+	}()
+	Ok("").Assert(nonEmpty, "name is set")
+}
+
+func TestInspect_Result(t *testing.T) {
+	errVal := errors.New("fail")
+	var seen []any
+	Ok(1).Inspect(func(v int) { seen = append(seen, v) }).InspectErr(func(err error) { seen = append(seen, err) })
+	Err[int](errVal).Inspect(func(v int) { seen = append(seen, v) }).InspectErr(func(err error) { seen = append(seen, err) })
+	if len(seen) != 2 || seen[0] != 1 || seen[1] != errVal {
+		t.Errorf("Unexpected calls: %v", seen)
+	}
+}
+
+func TestCloneFunc_Result(t *testing.T) {
+	r := Ok([]int{1})
+	c := r.CloneFunc(slices.Clone)
+	c.Get()[0] = 2
+	if !r.Has([]int{1}) {
+		t.Errorf("Expected CloneFunc to deep copy, got %v", r)
+	}
+}
+
+func TestThenFrom(t *testing.T) {
+	if r := ThenFrom(Ok("12"), strconv.Atoi); !r.Has(12) {
+		t.Errorf("Expected Ok(12), got %v", r)
+	}
+	if r := ThenFrom(Ok("x"), strconv.Atoi); !r.HasErr(strconv.ErrSyntax) {
+		t.Errorf("Expected ErrSyntax, got %v", r)
+	}
+}
+
+func TestFromFuncErr(t *testing.T) {
+	errVal := errors.New("fail")
+	if r := FromFuncErr(func() (int, error) { return 1, nil }); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	if r := FromFuncErr(func() (int, error) { return 0, errVal }); !r.HasErr(errVal) {
+		t.Errorf("Expected errVal, got %v", r)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected the must panic to propagate")
+		}
+	}()
+	FromFuncErr(func() (int, error) { util.MustNil(errVal); return 1, nil })
+}
+
+type statusVisitor struct{}
+
+func (statusVisitor) OkCase(v int) int      { return 200 }
+func (statusVisitor) ErrCase(err error) int { return 500 }
+
+func TestVisit(t *testing.T) {
+	if s := Visit[int, int](Ok(1), statusVisitor{}); s != 200 {
+		t.Errorf("Expected 200, got %d", s)
+	}
+	if s := Visit[int, int](Err[int](errors.New("fail")), statusVisitor{}); s != 500 {
+		t.Errorf("Expected 500, got %d", s)
+	}
+}
+
+func TestIf(t *testing.T) {
+	errForbidden := errors.New("forbidden")
+	if r := If(true, 1, errForbidden); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	if r := If(false, 1, errForbidden); !r.HasErr(errForbidden) {
+		t.Errorf("Expected Err(forbidden), got %v", r)
+	}
+	called := false
+	if r := IfFunc(false, func() int { called = true; return 1 }, errForbidden); !r.HasErr(errForbidden) || called {
+		t.Errorf("Expected Err without calling f, got %v", r)
+	}
+	if r := IfFunc(true, func() int { return 2 }, errForbidden); !r.Has(2) {
+		t.Errorf("Expected Ok(2), got %v", r)
+	}
+}
+
+func TestErrOrNil(t *testing.T) {
+	if err := Ok(1).ErrOrNil(); err != nil {
+		t.Errorf("Expected nil for Ok, got %v", err)
+	}
+	var pathErr *fs.PathError
+	r := Err[int](fmt.Errorf("load: %w", &fs.PathError{Op: "open", Err: fs.ErrNotExist}))
+	if !errors.Is(r.ErrOrNil(), fs.ErrNotExist) || !errors.As(r.ErrOrNil(), &pathErr) {
+		t.Errorf("Expected errors.Is/As to see through the error, got %v", r)
+	}
+}
+
+func TestThenResult(t *testing.T) {
+	errMissing := errors.New("missing")
+	atoi := func(s string) Result[int] { return From(strconv.Atoi(s)) }
+	if r := ThenResult(option.Val("3"), atoi, errMissing); !r.Has(3) {
+		t.Errorf("Expected Ok(3), got %v", r)
+	}
+	if r := ThenResult(option.Nul[string](), atoi, errMissing); !r.HasErr(errMissing) {
+		t.Errorf("Expected Err(missing), got %v", r)
+	}
+
+	users := map[int]string{1: "ann"}
+	lookup := func(id int) option.Option[string] { return option.FromMap(users, id) }
+	if r := ThenOption(Ok(1), lookup, errMissing); !r.Has("ann") {
+		t.Errorf("Expected Ok(ann), got %v", r)
+	}
+	if r := ThenOption(Ok(2), lookup, errMissing); !r.HasErr(errMissing) {
+		t.Errorf("Expected Err(missing), got %v", r)
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var r Result[int]
+	if !r.IsOk() || r.Get() != 0 || r.String() != Ok(0).String() {
+		t.Errorf("Expected the zero Result to be Ok(0), got %v", r)
+	}
+}
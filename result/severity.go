@@ -0,0 +1,93 @@
+package result
+
+import "errors"
+
+// =========================== 错误级别 ============================
+
+// 被标记为警告（可恢复）的错误，对 errors.Is/As 透明，也不改变错误消息
+type warningError struct {
+	err error
+}
+
+func (e *warningError) Error() string {
+	return e.err.Error()
+}
+
+func (e *warningError) Unwrap() error {
+	return e.err
+}
+
+// 判断错误链中是否存在被 AsWarning 标记的错误
+func IsWarning(err error) bool {
+	var w *warningError
+	return errors.As(err, &w)
+}
+
+// 将 Err 标记为警告，Ok 或已是警告时原样返回
+func (r Result[T]) AsWarning() Result[T] {
+	if r.IsOk() || IsWarning(r.err) {
+		return r
+	}
+	return Propagate[T](&warningError{err: r.err})
+}
+
+// 是否为被标记为警告的 Err
+func (r Result[T]) IsWarning() bool {
+	return r.IsErr() && IsWarning(r.err)
+}
+
+// 是否为未被标记为警告的 Err
+func (r Result[T]) IsFatal() bool {
+	return r.IsErr() && !IsWarning(r.err)
+}
+
+// 与 Catch 相同，但只在 Err 为警告时调用 f
+func (r Result[T]) OnWarning(f func(error)) Result[T] {
+	if !r.IsWarning() {
+		return r
+	}
+	return r.Catch(f)
+}
+
+// 与 Catch 相同，但只在 Err 不是警告时调用 f
+func (r Result[T]) OnFatal(f func(error)) Result[T] {
+	if !r.IsFatal() {
+		return r
+	}
+	return r.Catch(f)
+}
+
+// Err 为警告时将其记录到 c 中，并以 fallback 继续；其他情况原样返回
+func (r Result[T]) SkipWarning(c *Collector, fallback T) Result[T] {
+	if !r.IsWarning() {
+		return r
+	}
+	c.AddErr(r.err)
+	return Ok(fallback)
+}
+
+// 返回已记录的错误中被标记为警告的错误
+func (c *Collector) Warnings() []error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var warnings []error
+	for _, err := range c.errs {
+		if IsWarning(err) {
+			warnings = append(warnings, err)
+		}
+	}
+	return warnings
+}
+
+// 返回已记录的错误中未被标记为警告的错误通过 errors.Join 合并后的错误，没有时返回 nil
+func (c *Collector) Fatal() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var errs []error
+	for _, err := range c.errs {
+		if !IsWarning(err) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,51 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAsWarning(t *testing.T) {
+	errSoft := errors.New("missing optional column")
+	r := Err[int](errSoft).AsWarning()
+	if !r.IsWarning() || r.IsFatal() || !r.HasErr(errSoft) {
+		t.Errorf("Expected a warning wrapping errSoft, got %v", r)
+	}
+	if r.GetErr().Error() != errSoft.Error() {
+		t.Errorf("Expected the message to be unchanged, got %q", r.GetErr())
+	}
+	if Ok(1).AsWarning().IsWarning() {
+		t.Error("Expected Ok to stay Ok")
+	}
+	// Map 等组合子保留警告标记
+	if !Map(r, func(v int) int { return v }).IsWarning() {
+		t.Error("Expected the warning to survive Map")
+	}
+
+	var warned, fatal int
+	r.OnWarning(func(error) { warned++ }).OnFatal(func(error) { fatal++ })
+	Err[int](errSoft).OnWarning(func(error) { warned++ }).OnFatal(func(error) { fatal++ })
+	if warned != 1 || fatal != 1 {
+		t.Errorf("Expected one warning and one fatal callback, got %d and %d", warned, fatal)
+	}
+}
+
+func TestSkipWarning(t *testing.T) {
+	errSoft := errors.New("soft")
+	errHard := errors.New("hard")
+	var c Collector
+	rows := []Result[int]{Ok(1), Err[int](errSoft).AsWarning(), Ok(3), Err[int](errHard)}
+	sum := 0
+	for _, r := range rows {
+		r.SkipWarning(&c, 0).Try(func(v int) { sum += v }).Catch(c.AddErr)
+	}
+	if sum != 4 {
+		t.Errorf("Expected the pipeline to continue past the warning, got sum %d", sum)
+	}
+	if ws := c.Warnings(); len(ws) != 1 || !errors.Is(ws[0], errSoft) {
+		t.Errorf("Expected one warning, got %v", ws)
+	}
+	if err := c.Fatal(); !errors.Is(err, errHard) || errors.Is(err, errSoft) {
+		t.Errorf("Expected only the fatal error, got %v", err)
+	}
+}
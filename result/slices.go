@@ -0,0 +1,62 @@
+package result
+
+// =========================== 切片聚合 ============================
+
+// 将 []Result[T] 收集为 Result[[]T]，遇到第一个 Err 时直接返回该 Err
+func Collect[T any](rs []Result[T]) Result[[]T] {
+	vals := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.IsErr() {
+			return Propagate[[]T](r.err)
+		}
+		vals = append(vals, r.Get())
+	}
+	return Ok(vals)
+}
+
+// 将 []Result[T] 收集为 Result[[]T]，存在 Err 时返回包含所有错误及其下标的 IndexedErrors
+func CollectAll[T any](rs []Result[T]) Result[[]T] {
+	if errs := indexedErrs(rs); errs != nil {
+		return Propagate[[]T](errs)
+	}
+	vals := make([]T, 0, len(rs))
+	for _, r := range rs {
+		vals = append(vals, r.Get())
+	}
+	return Ok(vals)
+}
+
+// 一次遍历将 []Result[T] 拆分为所有成功的值和所有错误
+func Partition[T any](rs []Result[T]) ([]T, []error) {
+	var vals []T
+	var errs []error
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.err)
+			continue
+		}
+		vals = append(vals, r.Get())
+	}
+	return vals, errs
+}
+
+// 返回所有 Err 中的错误
+func Errors[T any](rs []Result[T]) []error {
+	var errs []error
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.err)
+		}
+	}
+	return errs
+}
+
+// 所有参数都为 Ok 时返回由这些值组成的切片，否则返回第一个 Err，与 Collect 相同
+func All[T any](rs ...Result[T]) Result[[]T] {
+	return Collect(rs)
+}
+
+// 返回第一个 Ok，都为 Err 时合并所有错误，与 FirstOk 相同
+func Any[T any](rs ...Result[T]) Result[T] {
+	return FirstOk(rs...)
+}
@@ -0,0 +1,77 @@
+package result
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	r := Collect([]Result[int]{Ok(1), Ok(2), Ok(3)})
+	if !r.IsOk() || !slices.Equal(r.Get(), []int{1, 2, 3}) {
+		t.Errorf("Expected Ok([1 2 3]), got %v", r)
+	}
+
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	r2 := Collect([]Result[int]{Ok(1), Err[int](err1), Err[int](err2)})
+	if !r2.HasErr(err1) || r2.HasErr(err2) {
+		t.Errorf("Expected Collect to return the first error, got %v", r2)
+	}
+
+	r3 := Collect[int](nil)
+	if !r3.IsOk() || len(r3.Get()) != 0 {
+		t.Errorf("Expected Ok([]) for empty input, got %v", r3)
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	r := CollectAll([]Result[string]{Ok("a"), Ok("b")})
+	if !r.IsOk() || !slices.Equal(r.Get(), []string{"a", "b"}) {
+		t.Errorf("Expected Ok([a b]), got %v", r)
+	}
+
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	r2 := CollectAll([]Result[string]{Err[string](err1), Ok("a"), Err[string](err2)})
+	if !r2.HasErr(err1) || !r2.HasErr(err2) {
+		t.Errorf("Expected CollectAll to join all errors, got %v", r2)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	err1 := errors.New("err1")
+	vals, errs := Partition([]Result[int]{Ok(1), Err[int](err1), Ok(2)})
+	if !slices.Equal(vals, []int{1, 2}) {
+		t.Errorf("Expected values [1 2], got %v", vals)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], err1) {
+		t.Errorf("Expected errors [err1], got %v", errs)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	errs := Errors([]Result[int]{Err[int](err1), Ok(1), Err[int](err2)})
+	if len(errs) != 2 || !errors.Is(errs[0], err1) || !errors.Is(errs[1], err2) {
+		t.Errorf("Expected errors [err1 err2], got %v", errs)
+	}
+
+	if errs := Errors([]Result[int]{Ok(1)}); len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+}
+
+func TestAllAny(t *testing.T) {
+	errVal := errors.New("fail")
+	if r := All(Ok(1), Ok(2)); !r.IsOk() || !slices.Equal(r.Get(), []int{1, 2}) {
+		t.Errorf("Expected Ok([1 2]), got %v", r)
+	}
+	if r := All(Ok(1), Err[int](errVal)); !r.HasErr(errVal) {
+		t.Errorf("Expected Err(fail), got %v", r)
+	}
+	if r := Any(Err[int](errVal), Ok(2)); !r.Has(2) {
+		t.Errorf("Expected Ok(2), got %v", r)
+	}
+}
@@ -0,0 +1,56 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/viocha/go-option/internal/common"
+)
+
+// ========================== log/slog =============================
+
+// 实现 slog.LogValuer：Ok 输出为 {ok=true value=...}，Err 输出为 {ok=false err=...}，
+// 敏感类型的值会被隐藏（参见 util.RedactType）
+func (r Result[T]) LogValue() slog.Value {
+	if r.IsErr() {
+		return slog.GroupValue(slog.Bool("ok", false), slog.String("err", r.err.Error()))
+	}
+	value := slog.AnyValue(r.val)
+	if common.IsRedacted(r.val) {
+		value = slog.StringValue(common.RedactedText)
+	}
+	return slog.GroupValue(slog.Bool("ok", true), slog.Attr{Key: "value", Value: value})
+}
+
+// Err 时使用 logger 记录一条日志并原样返回 r，Ok 时不做任何事。logger 为 nil 时使用 slog.Default()。
+// 日志包含 err、错误链中的其他错误 causes，以及由 panic 转换而来的错误的调用栈 stack；
+// 被 AsWarning 标记的错误以 Warn 级别记录，其他错误以 Error 级别记录
+func (r Result[T]) LogErr(logger *slog.Logger, msg string, attrs ...slog.Attr) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	// 调用方可能以 attrs... 传入切片，截断容量以免写入其底层数组
+	attrs = append(slices.Clip(attrs), slog.String("err", r.err.Error()))
+	if causes := r.Causes(); len(causes) > 1 {
+		msgs := make([]string, 0, len(causes)-1)
+		for _, c := range causes[1:] {
+			msgs = append(msgs, c.Error())
+		}
+		attrs = append(attrs, slog.Any("causes", msgs))
+	}
+	var pe *common.PanicError
+	if errors.As(r.err, &pe) {
+		attrs = append(attrs, slog.String("stack", string(pe.Stack())))
+	}
+	level := slog.LevelError
+	if r.IsWarning() {
+		level = slog.LevelWarn
+	}
+	logger.LogAttrs(context.Background(), level, msg, attrs...)
+	return r
+}
@@ -0,0 +1,74 @@
+package result
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/viocha/go-option/util"
+)
+
+type token string
+
+func TestLogValue(t *testing.T) {
+	util.RedactType[token]()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	logger.Info("fetch", "count", Ok(3), "user", Err[string](errors.New("not found")), "token", Ok(token("secret")))
+
+	out := buf.String()
+	for _, want := range []string{"count.ok=true count.value=3", `user.ok=false user.err="not found"`, "token.value=[REDACTED]"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s in %s", want, out)
+		}
+	}
+}
+
+func TestLogErr(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	Ok(1).LogErr(logger, "fetch failed")
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing logged for Ok, got %s", buf.String())
+	}
+
+	errBase := errors.New("not found")
+	r := Err[int](errBase).Wrap("load user").LogErr(logger, "fetch failed", slog.Int("id", 7))
+	if !r.HasErr(errBase) {
+		t.Errorf("Expected the receiver to be returned, got %v", r)
+	}
+	out := buf.String()
+	for _, want := range []string{"level=ERROR", `msg="fetch failed"`, "id=7", `err="load user: not found"`, `causes="[not found]"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s in %s", want, out)
+		}
+	}
+
+	buf.Reset()
+	Err[int](errBase).AsWarning().LogErr(logger, "skipped")
+	if !strings.Contains(buf.String(), "level=WARN") {
+		t.Errorf("Expected a warning to be logged at WARN, got %s", buf.String())
+	}
+
+	buf.Reset()
+	FromFunc(func() int { panic(util.WrapMust(errBase)) }).LogErr(logger, "panicked")
+	if !strings.Contains(buf.String(), "stack=") {
+		t.Errorf("Expected a stack for a panic, got %s", buf.String())
+	}
+}
+
+func TestLogErr_CallerAttrs(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	base := make([]slog.Attr, 1, 4)
+	base[0] = slog.Int("id", 7)
+	spare := base[:4]
+	Err[int](errors.New("boom")).LogErr(logger, "failed", base...)
+	if spare[1].Key != "" {
+		t.Errorf("Expected the caller's backing array to be untouched, got %v", spare[1])
+	}
+}
@@ -0,0 +1,106 @@
+package result
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// =========================== 扫描数据库行 ============================
+
+// *sql.Rows 实现了该接口
+type Rows interface {
+	Columns() ([]string, error)
+	Scan(dest ...any) error
+}
+
+// *sql.Rows 实现了该接口
+type RowsIter interface {
+	Rows
+	Next() bool
+	Err() error
+}
+
+var columnIndexes sync.Map // reflect.Type -> map[string][]int
+
+// 将当前行扫描到新的 T 结构体中，列按名称对应到字段：字段可以通过 `db:"name"` 标签指定列名，`db:"-"` 跳过该字段，
+// 否则忽略大小写和下划线比较（user_id 对应 UserID）。Option 字段中 NULL 扫描为 Nul，没有对应字段的列被忽略
+func ScanRow[T any](rows Rows) Result[T] {
+	var dest T
+	v := reflect.ValueOf(&dest).Elem()
+	if v.Kind() != reflect.Struct {
+		return Err[T](fmt.Errorf("ScanRow: %T is not a struct", dest))
+	}
+	cols, err := rows.Columns()
+	if err != nil {
+		return Err[T](err)
+	}
+	index := columnIndexOf(v.Type())
+	targets := make([]any, len(cols))
+	for i, col := range cols {
+		if fi, ok := index[normalizeColumn(col)]; ok {
+			targets[i] = v.FieldByIndex(fi).Addr().Interface()
+		} else {
+			targets[i] = new(any)
+		}
+	}
+	if err := rows.Scan(targets...); err != nil {
+		return Err[T](err)
+	}
+	return Ok(dest)
+}
+
+// 扫描所有剩余的行，遇到第一个错误时停止
+func ScanAll[T any](rows RowsIter) Result[[]T] {
+	var out []T
+	for rows.Next() {
+		r := ScanRow[T](rows)
+		if r.IsErr() {
+			return Propagate[[]T](r.err)
+		}
+		out = append(out, r.val)
+	}
+	if err := rows.Err(); err != nil {
+		return Err[[]T](err)
+	}
+	return Ok(out)
+}
+
+func columnIndexOf(t reflect.Type) map[string][]int {
+	if index, ok := columnIndexes.Load(t); ok {
+		return index.(map[string][]int)
+	}
+	index := make(map[string][]int)
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous || throughPointer(t, f.Index) {
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("db"); ok {
+			if tag == "-" {
+				continue
+			}
+			name = tag
+		}
+		index[normalizeColumn(name)] = f.Index
+	}
+	columnIndexes.Store(t, index)
+	return index
+}
+
+// 嵌入的指针字段可能为 nil，不通过它们访问字段
+func throughPointer(t reflect.Type, index []int) bool {
+	for _, i := range index[:len(index)-1] {
+		f := t.Field(i)
+		if f.Type.Kind() == reflect.Pointer {
+			return true
+		}
+		t = f.Type
+	}
+	return false
+}
+
+func normalizeColumn(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
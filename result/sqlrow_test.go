@@ -0,0 +1,90 @@
+package result
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/viocha/go-option"
+)
+
+// 模拟 *sql.Rows，使用 database/sql 的转换规则将值赋给目标
+type fakeRows struct {
+	cols []string
+	rows [][]any
+	i    int
+}
+
+func (r *fakeRows) Columns() ([]string, error) { return r.cols, nil }
+func (r *fakeRows) Next() bool                 { r.i++; return r.i <= len(r.rows) }
+func (r *fakeRows) Err() error                 { return nil }
+
+func (r *fakeRows) Scan(dest ...any) error {
+	row := r.rows[r.i-1]
+	for i, d := range dest {
+		if s, ok := d.(sql.Scanner); ok {
+			if err := s.Scan(row[i]); err != nil {
+				return err
+			}
+			continue
+		}
+		dv := reflect.ValueOf(d).Elem()
+		if row[i] == nil {
+			if dv.Kind() != reflect.Interface {
+				return fmt.Errorf("column %s: converting NULL to %s is unsupported", r.cols[i], dv.Type())
+			}
+			continue
+		}
+		dv.Set(reflect.ValueOf(row[i]))
+	}
+	return nil
+}
+
+type audit struct {
+	UpdatedBy option.Option[string]
+}
+
+type userRow struct {
+	audit
+	UserID    int64
+	Name      string
+	Nickname  option.Option[string]
+	CreatedAt option.Option[time.Time] `db:"created"`
+	Ignored   string                   `db:"-"`
+}
+
+func TestScanRow(t *testing.T) {
+	now := time.Now()
+	rows := &fakeRows{
+		cols: []string{"user_id", "name", "nickname", "created", "extra", "updated_by"},
+		rows: [][]any{
+			{int64(1), "ann", nil, now, "x", "root"},
+			{int64(2), "bob", "b", nil, "y", nil},
+		},
+	}
+	r := ScanAll[userRow](rows)
+	if !r.IsOk() || len(r.Get()) != 2 {
+		t.Fatalf("Expected two rows, got %v", r)
+	}
+	ann, bob := r.Get()[0], r.Get()[1]
+	if ann.UserID != 1 || ann.Name != "ann" || ann.Nickname.IsVal() || !ann.CreatedAt.HasFunc(now.Equal) {
+		t.Errorf("Unexpected first row %+v", ann)
+	}
+	if !ann.UpdatedBy.Has("root") || bob.UpdatedBy.IsVal() {
+		t.Errorf("Expected embedded fields to be scanned, got %v and %v", ann.UpdatedBy, bob.UpdatedBy)
+	}
+	if !bob.Nickname.Has("b") || bob.CreatedAt.IsVal() {
+		t.Errorf("Unexpected second row %+v", bob)
+	}
+
+	bad := &fakeRows{cols: []string{"name"}, rows: [][]any{{nil}}}
+	bad.Next()
+	if r := ScanRow[userRow](bad); r.IsOk() {
+		t.Errorf("Expected NULL into a plain string to fail, got %v", r)
+	}
+	if r := ScanRow[int](bad); r.IsOk() {
+		t.Errorf("Expected a non-struct target to fail, got %v", r)
+	}
+}
@@ -0,0 +1,72 @@
+package result
+
+import "errors"
+
+// ========================== 按错误分派 =============================
+
+// 按错误类型分派处理函数的构造器，通过 Result.Switch 创建
+type ErrSwitch[T any] struct {
+	r     Result[T]
+	cases []errCase[T]
+	def   func(error) Result[T]
+}
+
+type errCase[T any] struct {
+	match  func(error) bool
+	handle func(error) Result[T]
+}
+
+// 开始按错误分派，例如：
+//
+//	r.Switch().
+//		CaseIs(ErrNotFound, h1).
+//		CaseAs(&validationErr, h2).
+//		Default(h3).
+//		Run()
+func (r Result[T]) Switch() *ErrSwitch[T] {
+	return &ErrSwitch[T]{r: r}
+}
+
+// 错误链中存在 target 时（参见 errors.Is）调用 f
+func (s *ErrSwitch[T]) CaseIs(target error, f func(error) Result[T]) *ErrSwitch[T] {
+	return s.CaseFunc(func(err error) bool { return errors.Is(err, target) }, f)
+}
+
+// 错误链中存在可以赋值给 target 的错误时（参见 errors.As）调用 f，调用前 target 已被设置
+func (s *ErrSwitch[T]) CaseAs(target any, f func(error) Result[T]) *ErrSwitch[T] {
+	return s.CaseFunc(func(err error) bool { return errors.As(err, target) }, f)
+}
+
+// match 返回 true 时调用 f
+func (s *ErrSwitch[T]) CaseFunc(match func(error) bool, f func(error) Result[T]) *ErrSwitch[T] {
+	s.cases = append(s.cases, errCase[T]{match: match, handle: f})
+	return s
+}
+
+// 没有分支匹配时调用 f
+func (s *ErrSwitch[T]) Default(f func(error) Result[T]) *ErrSwitch[T] {
+	s.def = f
+	return s
+}
+
+// 按添加顺序调用第一个匹配的分支并返回其结果。Ok 时原样返回，没有分支匹配且没有 Default 时原样返回 Err。
+// 与 Else 相同，处理函数中的 must panic 会被转换为 Err
+func (s *ErrSwitch[T]) Run() Result[T] {
+	if s.r.IsOk() {
+		return s.r
+	}
+	for _, c := range s.cases {
+		if c.match(s.r.err) {
+			return s.r.Else(c.handle)
+		}
+	}
+	if s.def != nil {
+		return s.r.Else(s.def)
+	}
+	return s.r
+}
+
+// 与 Run 相同，但返回值，最终仍为 Err 时返回 fallback
+func (s *ErrSwitch[T]) RunOr(fallback T) T {
+	return s.Run().GetOr(fallback)
+}
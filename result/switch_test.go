@@ -0,0 +1,43 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type validationError struct{ field string }
+
+func (e *validationError) Error() string { return "invalid " + e.field }
+
+func TestSwitch(t *testing.T) {
+	errNotFound := errors.New("not found")
+	sw := func(r Result[int]) *ErrSwitch[int] {
+		var ve *validationError
+		return r.Switch().
+			CaseIs(errNotFound, func(error) Result[int] { return Ok(0) }).
+			CaseAs(&ve, func(error) Result[int] { return Ok(len(ve.field)) }).
+			Default(func(err error) Result[int] { return Err[int](fmt.Errorf("unexpected: %w", err)) })
+	}
+
+	if r := sw(Ok(5)).Run(); !r.Has(5) {
+		t.Errorf("Expected Ok(5) to pass through, got %v", r)
+	}
+	if r := sw(Err[int](fmt.Errorf("load: %w", errNotFound))).Run(); !r.Has(0) {
+		t.Errorf("Expected CaseIs to match wrapped error, got %v", r)
+	}
+	if r := sw(Err[int](&validationError{field: "name"})).Run(); !r.Has(4) {
+		t.Errorf("Expected CaseAs to set target, got %v", r)
+	}
+	if r := sw(Err[int](errors.New("boom"))).Run(); r.IsOk() || r.GetErr().Error() != "unexpected: boom" {
+		t.Errorf("Expected Default to handle error, got %v", r)
+	}
+	if v := sw(Err[int](errNotFound)).RunOr(-1); v != 0 {
+		t.Errorf("Expected 0, got %d", v)
+	}
+
+	r := Err[int](errors.New("boom"))
+	if got := r.Switch().CaseIs(errNotFound, func(error) Result[int] { return Ok(0) }).Run(); !got.HasErr(r.GetErr()) {
+		t.Errorf("Expected unmatched Err to pass through, got %v", got)
+	}
+}
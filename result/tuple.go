@@ -0,0 +1,21 @@
+package result
+
+import "github.com/viocha/go-option/tuple"
+
+// =========================== 多返回值 ============================
+
+// 将 (A, B, error) 形式的返回值转换为 Result[tuple.Pair[A, B]]
+func From2[A, B any](a A, b B, err error) Result[tuple.Pair[A, B]] {
+	if err != nil {
+		return Err[tuple.Pair[A, B]](err)
+	}
+	return Ok(tuple.NewPair(a, b))
+}
+
+// 将 (A, B, C, error) 形式的返回值转换为 Result[tuple.Triple[A, B, C]]
+func From3[A, B, C any](a A, b B, c C, err error) Result[tuple.Triple[A, B, C]] {
+	if err != nil {
+		return Err[tuple.Triple[A, B, C]](err)
+	}
+	return Ok(tuple.NewTriple(a, b, c))
+}
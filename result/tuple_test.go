@@ -0,0 +1,27 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/viocha/go-option/tuple"
+)
+
+func split(s string) (string, int, error) {
+	if s == "" {
+		return "", 0, errors.New("empty")
+	}
+	return s, len(s), nil
+}
+
+func TestFrom2(t *testing.T) {
+	if r := From2(split("ab")); !r.Has(tuple.NewPair("ab", 2)) {
+		t.Errorf("Expected Ok((ab, 2)), got %v", r)
+	}
+	if r := From2(split("")); r.IsOk() {
+		t.Errorf("Expected Err, got %v", r)
+	}
+	if r := From3(1, "a", true, nil); !r.Has(tuple.NewTriple(1, "a", true)) {
+		t.Errorf("Expected Ok((1, a, true)), got %v", r)
+	}
+}
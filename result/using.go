@@ -0,0 +1,40 @@
+package result
+
+import (
+	"errors"
+	"io"
+)
+
+// =========================== 资源管理 ============================
+
+// 获取资源后调用 use，无论 use 返回 Err 还是发生 panic，release 都会被调用。
+// acquire 失败时不调用 use 和 release；release 返回的错误与 use 的错误合并后返回
+func Using[T any, U any](acquire func() (T, error), use func(T) Result[U], release func(T) error) Result[U] {
+	res := callSource(func() Result[T] { return From(acquire()) })
+	if res.IsErr() {
+		return Propagate[U](res.err)
+	}
+	v := res.val
+	released := false
+	defer func() {
+		// use 中发生 ErrMust 以外的 panic 时仍然释放资源，然后继续 panic
+		if !released {
+			_ = release(v)
+		}
+	}()
+	r := callSource(func() Result[U] { return use(v) })
+	released = true
+	relErr := callSource(func() Result[struct{}] { return From(struct{}{}, release(v)) })
+	if relErr.IsOk() {
+		return r
+	}
+	if r.IsErr() {
+		return Propagate[U](errors.Join(r.err, relErr.err))
+	}
+	return Propagate[U](relErr.err)
+}
+
+// 与 Using 相同，使用资源的 Close 方法释放资源
+func WithClose[T io.Closer, U any](acquire func() (T, error), use func(T) Result[U]) Result[U] {
+	return Using(acquire, use, func(c T) error { return c.Close() })
+}
@@ -0,0 +1,66 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/viocha/go-option/util"
+)
+
+type resource struct {
+	closed   int
+	closeErr error
+}
+
+func (r *resource) Close() error {
+	r.closed++
+	return r.closeErr
+}
+
+func TestUsing(t *testing.T) {
+	res := &resource{}
+	open := func() (*resource, error) { return res, nil }
+
+	r := WithClose(open, func(*resource) Result[int] { return Ok(1) })
+	if !r.Has(1) || res.closed != 1 {
+		t.Errorf("Expected Ok(1) and one Close, got %v and %d", r, res.closed)
+	}
+
+	errUse := errors.New("use")
+	errClose := errors.New("close")
+	res.closeErr = errClose
+	r = WithClose(open, func(*resource) Result[int] { return Err[int](errUse) })
+	if !r.HasErr(errUse) || !r.HasErr(errClose) || res.closed != 2 {
+		t.Errorf("Expected both errors and a Close, got %v and %d", r, res.closed)
+	}
+	r = WithClose(open, func(*resource) Result[int] { return Ok(1) })
+	if !r.HasErr(errClose) {
+		t.Errorf("Expected the close error, got %v", r)
+	}
+
+	r = WithClose(open, func(*resource) Result[int] { util.MustNil(errUse); return Ok(1) })
+	if !r.HasErr(errUse) || res.closed != 4 {
+		t.Errorf("Expected a must panic to be captured and released, got %v and %d", r, res.closed)
+	}
+}
+
+func TestUsing_AcquireFails(t *testing.T) {
+	errOpen := errors.New("open")
+	called := false
+	r := Using(func() (int, error) { return 0, errOpen },
+		func(int) Result[int] { called = true; return Ok(1) },
+		func(int) error { called = true; return nil })
+	if !r.HasErr(errOpen) || called {
+		t.Errorf("Expected Err(open) without use or release, got %v", r)
+	}
+}
+
+func TestUsing_Panic(t *testing.T) {
+	res := &resource{}
+	defer func() {
+		if recover() == nil || res.closed != 1 {
+			t.Errorf("Expected the panic to propagate after Close, closed %d", res.closed)
+		}
+	}()
+	WithClose(func() (*resource, error) { return res, nil }, func(*resource) Result[int] { panic("boom") })
+}
@@ -0,0 +1,42 @@
+package result
+
+import "fmt"
+
+// =========================== 并发原语适配 ============================
+
+// 将 errgroup.Group.Wait、sync.WaitGroup 配合错误变量等“先等待，再读取结果”的接口转换为 Result：
+// err 不为 nil 时返回 Err(err)，否则调用 collect 获取结果
+//
+//	r := result.FromWait(g.Wait(), func() []User { return users })
+func FromWait[T any](err error, collect func() []T) Result[[]T] {
+	if err != nil {
+		return Err[[]T](err)
+	}
+	return FromFunc(collect)
+}
+
+// 带有是否与其他调用共享结果标记的 Result，由 FromShared 返回
+type Shared[T any] struct {
+	Result[T]
+	shared bool
+}
+
+// 结果是否与其他并发调用共享
+func (s Shared[T]) Shared() bool {
+	return s.shared
+}
+
+// 将 singleflight.Group.Do 返回的 (v, err, shared) 转换为 Shared[T]，
+// err 为 nil 但 v 不是 T 时返回描述类型不匹配的 Err
+//
+//	s := result.FromShared[User](g.Do(key, load))
+func FromShared[T any](v any, err error, shared bool) Shared[T] {
+	if err != nil {
+		return Shared[T]{Result: Err[T](err), shared: shared}
+	}
+	tv, ok := v.(T)
+	if !ok && v != nil {
+		return Shared[T]{Result: Err[T](fmt.Errorf("FromShared: got %T, want %T", v, tv)), shared: shared}
+	}
+	return Shared[T]{Result: Ok(tv), shared: shared}
+}
@@ -0,0 +1,45 @@
+package result
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestFromWait(t *testing.T) {
+	var wg sync.WaitGroup
+	out := make([]int, 3)
+	for i := range out {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			out[i] = i * i
+		}()
+	}
+	wg.Wait()
+	if r := FromWait(nil, func() []int { return out }); !r.IsOk() || !slices.Equal(r.Get(), []int{0, 1, 4}) {
+		t.Errorf("Expected Ok([0 1 4]), got %v", r)
+	}
+	errFail := errors.New("fail")
+	called := false
+	if r := FromWait(errFail, func() []int { called = true; return out }); !r.HasErr(errFail) || called {
+		t.Errorf("Expected Err(fail) without collecting, got %v", r)
+	}
+}
+
+func TestFromShared(t *testing.T) {
+	if s := FromShared[string]("v", nil, true); !s.Has("v") || !s.Shared() {
+		t.Errorf("Expected shared Ok(v), got %v", s)
+	}
+	errFail := errors.New("fail")
+	if s := FromShared[string](nil, errFail, false); !s.HasErr(errFail) || s.Shared() {
+		t.Errorf("Expected Err(fail), got %v", s)
+	}
+	if s := FromShared[string](1, nil, false); s.IsOk() {
+		t.Errorf("Expected a type mismatch error, got %v", s)
+	}
+	if s := FromShared[*int](nil, nil, false); !s.IsOk() || s.Get() != nil {
+		t.Errorf("Expected Ok(nil), got %v", s)
+	}
+}
@@ -0,0 +1,66 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+
+	opt "github.com/viocha/go-option"
+)
+
+// =========================== 工作流 ============================
+
+// 由工作流中名为 Step 的步骤返回的错误
+type StepError struct {
+	Step string
+	Err  error
+}
+
+func (e *StepError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Step, e.Err)
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// 与 Then 相同，但 f 返回的错误会被包装为 StepError，其中记录了步骤名 name
+func ThenStep[T any, U any](r Result[T], name string, f func(T) Result[U]) Result[U] {
+	if r.IsErr() {
+		return Propagate[U](r.err)
+	}
+	return Then(r, f).MapErr(func(err error) error { return &StepError{Step: name, Err: err} })
+}
+
+// 返回错误链中最外层的 StepError 的步骤名，Ok 或错误不来自任何步骤时返回 Nul
+func (r Result[T]) FailedStep() opt.Option[string] {
+	var se *StepError
+	if r.IsOk() || !errors.As(r.err, &se) {
+		return opt.Nul[string]()
+	}
+	return opt.Val(se.Step)
+}
+
+// 由具名步骤组成的工作流，每一步接收上一步的结果，零值可以直接使用
+type Workflow[T any] struct {
+	steps []workflowStep[T]
+}
+
+type workflowStep[T any] struct {
+	name string
+	f    func(T) Result[T]
+}
+
+// 追加一个步骤并返回 w 本身，便于链式调用
+func (w *Workflow[T]) Step(name string, f func(T) Result[T]) *Workflow[T] {
+	w.steps = append(w.steps, workflowStep[T]{name: name, f: f})
+	return w
+}
+
+// 以 v 为输入依次执行所有步骤，在第一个失败的步骤处停止，其错误被包装为 StepError
+func (w *Workflow[T]) Run(v T) Result[T] {
+	r := Ok(v)
+	for _, s := range w.steps {
+		r = ThenStep(r, s.name, s.f)
+	}
+	return r
+}
@@ -0,0 +1,40 @@
+package result
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+type order struct {
+	ID    string
+	Total int
+}
+
+func TestWorkflow(t *testing.T) {
+	errPrice := errors.New("no price")
+	var w Workflow[order]
+	w.Step("fetch", func(o order) Result[order] { o.ID = "o1"; return Ok(o) }).
+		Step("price", func(o order) Result[order] { return Err[order](errPrice) }).
+		Step("save", func(o order) Result[order] { t.Error("Expected save not to run"); return Ok(o) })
+
+	r := w.Run(order{})
+	if !r.HasErr(errPrice) || !r.FailedStep().Has("price") {
+		t.Errorf("Expected a failure in the price step, got %v", r)
+	}
+	if msg := r.GetErr().Error(); msg != "price: no price" {
+		t.Errorf("Expected the step name in the message, got %q", msg)
+	}
+	var ok Workflow[int]
+	if r := ok.Step("inc", func(v int) Result[int] { return Ok(v + 1) }).Run(1); !r.Has(2) || r.FailedStep().IsVal() {
+		t.Errorf("Expected Ok(2) without a failed step, got %v", r)
+	}
+}
+
+func TestThenStep(t *testing.T) {
+	r := ThenStep(Ok("x"), "parse", func(s string) Result[int] { return From(strconv.Atoi(s)) })
+	r = ThenStep(r, "double", func(v int) Result[int] { return Ok(v * 2) })
+	if !r.FailedStep().Has("parse") || !r.HasErr(strconv.ErrSyntax) {
+		t.Errorf("Expected the parse step to be reported, got %v", r)
+	}
+}
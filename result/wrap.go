@@ -0,0 +1,21 @@
+package result
+
+import "fmt"
+
+// =========================== 错误上下文 ============================
+
+// Err 时以 "msg: err" 的形式包装错误，Ok 时原样返回。包装后的错误仍然可以使用 errors.Is/As 匹配原错误
+func (r Result[T]) Wrap(msg string) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Propagate[T](fmt.Errorf("%s: %w", msg, r.err))
+}
+
+// 与 Wrap 相同，消息由 format 和 args 格式化得到
+func (r Result[T]) Wrapf(format string, args ...any) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Propagate[T](fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), r.err))
+}
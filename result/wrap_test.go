@@ -0,0 +1,31 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	errNotFound := errors.New("not found")
+	r := Err[int](errNotFound).Wrap("load user").Wrapf("request %d", 7)
+	if !r.HasErr(errNotFound) || r.GetErr().Error() != "request 7: load user: not found" {
+		t.Errorf("Expected wrapped error, got %v", r)
+	}
+	if r := Ok(1).Wrap("x"); !r.Has(1) {
+		t.Errorf("Expected Ok to be untouched, got %v", r)
+	}
+}
+
+func TestWithField(t *testing.T) {
+	errNotFound := errors.New("not found")
+	r := Err[int](errNotFound).WithField("user", 42).Wrap("load")
+	if r.GetErr().Error() != "load: not found [user=42]" {
+		t.Errorf("Unexpected message: %v", r.GetErr())
+	}
+	if !r.Meta("user").Has(42) || !r.HasErr(errNotFound) {
+		t.Errorf("Expected field to be readable as metadata, got %v", r)
+	}
+	if Fingerprint(r) != Fingerprint(Err[int](errNotFound).Wrap("load")) {
+		t.Error("Expected fields not to change the fingerprint")
+	}
+}
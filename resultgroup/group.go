@@ -0,0 +1,130 @@
+package resultgroup
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/result"
+)
+
+// 类似 errgroup.Group，但保留每个任务的返回值
+type Group[T any] struct {
+	cancel     context.CancelCauseFunc
+	sem        chan struct{}
+	collectAll bool
+
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	results  []result.Result[T]
+	firstErr error
+}
+
+type GroupOption func(*groupConfig)
+
+type groupConfig struct {
+	limit      int
+	collectAll bool
+}
+
+// 最多同时运行 n 个任务，达到上限时 Go 会阻塞，n <= 0 表示不限制
+func Limit(n int) GroupOption {
+	return func(c *groupConfig) { c.limit = n }
+}
+
+// 某个任务失败时不取消其他任务，Wait 返回所有错误的合并
+func CollectErrors() GroupOption {
+	return func(c *groupConfig) { c.collectAll = true }
+}
+
+// 创建一个任务组，默认在第一个任务失败后不再启动新任务，Wait 返回该错误
+func New[T any](opts ...GroupOption) *Group[T] {
+	g, _ := WithContext[T](context.Background(), opts...)
+	return g
+}
+
+// 创建一个任务组以及派生自 ctx 的上下文，该上下文在第一个任务失败（CollectErrors 时不会）或 Wait 返回时取消
+func WithContext[T any](ctx context.Context, opts ...GroupOption) (*Group[T], context.Context) {
+	var cfg groupConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	g := &Group[T]{cancel: cancel, collectAll: cfg.collectAll}
+	if cfg.limit > 0 {
+		g.sem = make(chan struct{}, cfg.limit)
+	}
+	return g, ctx
+}
+
+// 启动一个任务，其结果按 Go 的调用顺序出现在 Wait 的返回值中。f 中的 ErrMust panic 会被转换为 Err
+func (g *Group[T]) Go(f func() result.Result[T]) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.mu.Lock()
+	i := len(g.results)
+	g.results = append(g.results, result.Result[T]{})
+	skip := g.firstErr != nil && !g.collectAll
+	g.mu.Unlock()
+	if skip {
+		g.release()
+		return
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer g.release()
+		var r result.Result[T]
+		if err := must.CatchMustPanic(func() {
+			r = f()
+		}); err != nil {
+			r = result.Err[T](err)
+		}
+		g.mu.Lock()
+		g.results[i] = r
+		first := r.IsErr() && g.firstErr == nil
+		if first {
+			g.firstErr = r.GetErr()
+		}
+		g.mu.Unlock()
+		if first && !g.collectAll {
+			g.cancel(r.GetErr())
+		}
+	}()
+}
+
+func (g *Group[T]) release() {
+	if g.sem != nil {
+		<-g.sem
+	}
+}
+
+// 等待所有任务结束。全部为 Ok 时按启动顺序返回所有值；
+// 否则默认返回第一个错误，使用 CollectErrors 时返回所有错误的合并
+func (g *Group[T]) Wait() result.Result[[]T] {
+	g.wg.Wait()
+	g.cancel(nil)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.firstErr == nil {
+		vals := make([]T, len(g.results))
+		for i, r := range g.results {
+			vals[i] = r.Get()
+		}
+		return result.Ok(vals)
+	}
+	if !g.collectAll {
+		return result.Err[[]T](g.firstErr)
+	}
+	var errs []error
+	for _, r := range g.results {
+		if r.IsErr() {
+			errs = append(errs, r.GetErr())
+		}
+	}
+	return result.Err[[]T](errors.Join(errs...))
+}
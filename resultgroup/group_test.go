@@ -0,0 +1,62 @@
+package resultgroup
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestGroup(t *testing.T) {
+	g := New[int](Limit(2))
+	var running, peak atomic.Int32
+	for i := range 5 {
+		g.Go(func() result.Result[int] {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+			return result.Ok(i * i)
+		})
+	}
+	r := g.Wait()
+	if !r.IsOk() || !slices.Equal(r.Get(), []int{0, 1, 4, 9, 16}) {
+		t.Errorf("Expected values in Go order, got %v", r)
+	}
+	if peak.Load() > 2 {
+		t.Errorf("Expected at most 2 concurrent tasks, got %d", peak.Load())
+	}
+}
+
+func TestGroup_FailFast(t *testing.T) {
+	errFail := errors.New("fail")
+	g, ctx := WithContext[int](context.Background())
+	g.Go(func() result.Result[int] { return result.Err[int](errFail) })
+	g.Go(func() result.Result[int] {
+		<-ctx.Done()
+		return result.Err[int](context.Cause(ctx))
+	})
+	if r := g.Wait(); !r.HasErr(errFail) {
+		t.Errorf("Expected the first error, got %v", r)
+	}
+}
+
+func TestGroup_CollectErrors(t *testing.T) {
+	err1, err2 := errors.New("err1"), errors.New("err2")
+	g := New[int](CollectErrors())
+	g.Go(func() result.Result[int] { return result.Err[int](err1) })
+	g.Go(func() result.Result[int] { return result.Ok(1) })
+	g.Go(func() result.Result[int] { return result.Err[int](err2) })
+	if r := g.Wait(); !r.HasErr(err1) || !r.HasErr(err2) {
+		t.Errorf("Expected all errors to be joined, got %v", r)
+	}
+}
@@ -0,0 +1,83 @@
+package resulttest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/viocha/go-option/internal/common"
+	"github.com/viocha/go-option/result"
+)
+
+// ========================== 断言 =============================
+
+// 断言 r 为 Ok
+func AssertOk[T any](t testing.TB, r result.Result[T]) bool {
+	t.Helper()
+	if r.IsErr() {
+		t.Errorf("Expected Ok, got %v", r)
+		return false
+	}
+	return true
+}
+
+// 断言 r 为 Ok 并返回其值，否则立即终止测试
+func RequireOk[T any](t testing.TB, r result.Result[T]) T {
+	t.Helper()
+	if r.IsErr() {
+		t.Fatalf("Expected Ok, got %v", r)
+	}
+	return r.Get()
+}
+
+// 断言 r 为 Err
+func AssertErr[T any](t testing.TB, r result.Result[T]) bool {
+	t.Helper()
+	if r.IsOk() {
+		t.Errorf("Expected Err, got %v", r)
+		return false
+	}
+	return true
+}
+
+// 断言 r 为 Err 并返回其错误，否则立即终止测试
+func RequireErr[T any](t testing.TB, r result.Result[T]) error {
+	t.Helper()
+	if r.IsOk() {
+		t.Fatalf("Expected Err, got %v", r)
+	}
+	return r.GetErr()
+}
+
+// 断言 r 为 Err，且错误链中包含 target
+func AssertErrIs[T any](t testing.TB, r result.Result[T], target error) bool {
+	t.Helper()
+	if !r.HasErr(target) {
+		t.Errorf("Expected Err matching %v, got %v", target, r)
+		return false
+	}
+	return true
+}
+
+// 断言 r 为 Err，且错误链中存在可以赋值给 target 的错误
+func AssertErrAs[T any](t testing.TB, r result.Result[T], target any) bool {
+	t.Helper()
+	if r.IsOk() || !errors.As(r.GetErr(), target) {
+		t.Errorf("Expected Err assignable to %T, got %v", target, r)
+		return false
+	}
+	return true
+}
+
+// 断言 r 为 Ok(want)，失败时输出值的差异
+func AssertHas[T any](t testing.TB, r result.Result[T], want T) bool {
+	t.Helper()
+	if r.IsErr() {
+		t.Errorf("Expected Ok(%v), got %v", want, r)
+		return false
+	}
+	if !r.Has(want) {
+		t.Errorf("Unexpected Ok value %s", common.Diff(want, r.Get()))
+		return false
+	}
+	return true
+}
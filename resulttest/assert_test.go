@@ -0,0 +1,26 @@
+package resulttest
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestAssert(t *testing.T) {
+	errVal := errors.New("fail")
+	AssertOk(t, result.Ok(1))
+	AssertErr(t, result.Err[int](errVal))
+	AssertErrIs(t, result.Err[int](fmt.Errorf("wrap: %w", errVal)), errVal)
+	AssertHas(t, result.Ok("a"), "a")
+	if RequireOk(t, result.Ok(2)) != 2 {
+		t.Error("Expected RequireOk to return the value")
+	}
+	if RequireErr(t, result.Err[int](errVal)) != errVal {
+		t.Error("Expected RequireErr to return the error")
+	}
+	var pe *fs.PathError
+	AssertErrAs(t, result.Err[int](&fs.PathError{Op: "open", Err: errVal}), &pe)
+}
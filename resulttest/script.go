@@ -0,0 +1,74 @@
+package resulttest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+var ErrExhausted = errors.New("script exhausted")
+
+// 按顺序返回预设结果的测试替身，可安全地并发调用
+type Scripted[T any] struct {
+	mu     sync.Mutex
+	steps  []result.Result[T]
+	calls  int
+	repeat bool
+}
+
+// 创建一个按顺序返回 steps 的脚本，所有结果返回完后默认返回 ErrExhausted
+func Script[T any](steps ...result.Result[T]) *Scripted[T] {
+	return &Scripted[T]{steps: steps}
+}
+
+// 所有结果返回完后重复返回最后一个结果
+func (s *Scripted[T]) RepeatLast() *Scripted[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.repeat = true
+	return s
+}
+
+// 返回下一个预设结果
+func (s *Scripted[T]) Call() result.Result[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.calls
+	s.calls++
+	switch {
+	case i < len(s.steps):
+		return s.steps[i]
+	case s.repeat && len(s.steps) > 0:
+		return s.steps[len(s.steps)-1]
+	}
+	return result.Err[T](fmt.Errorf("%w: call %d of %d scripted", ErrExhausted, i+1, len(s.steps)))
+}
+
+// 以 func() Result[T] 的形式返回脚本
+func (s *Scripted[T]) Func() func() result.Result[T] {
+	return s.Call
+}
+
+// 以 func(context.Context) Result[T] 的形式返回脚本，ctx 被忽略
+func (s *Scripted[T]) CtxFunc() func(context.Context) result.Result[T] {
+	return func(context.Context) result.Result[T] { return s.Call() }
+}
+
+// 已被调用的次数
+func (s *Scripted[T]) Calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+// 断言已被调用 want 次
+func (s *Scripted[T]) AssertCalls(t testing.TB, want int) {
+	t.Helper()
+	if got := s.Calls(); got != want {
+		t.Errorf("Expected %d calls, got %d", want, got)
+	}
+}
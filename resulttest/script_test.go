@@ -0,0 +1,35 @@
+package resulttest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestScript(t *testing.T) {
+	errFlaky := errors.New("flaky")
+	s := Script(result.Err[int](errFlaky), result.Ok(1))
+	f := s.Func()
+	if r := f(); !r.HasErr(errFlaky) {
+		t.Errorf("Expected Err(flaky), got %v", r)
+	}
+	if r := f(); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	if r := f(); !r.HasErr(ErrExhausted) {
+		t.Errorf("Expected ErrExhausted, got %v", r)
+	}
+	s.AssertCalls(t, 3)
+}
+
+func TestScript_RepeatLast(t *testing.T) {
+	s := Script(result.Ok("a"), result.Ok("b")).RepeatLast()
+	for range 3 {
+		s.Call()
+	}
+	if r := s.Call(); !r.Has("b") {
+		t.Errorf("Expected the last step to repeat, got %v", r)
+	}
+	s.AssertCalls(t, 4)
+}
@@ -0,0 +1,101 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/viocha/go-option/clock"
+	"github.com/viocha/go-option/result"
+)
+
+var (
+	ErrDeadline = errors.New("retry deadline exceeded")
+)
+
+// 一次尝试的元数据
+type Attempt struct {
+	Number  int           // 从 1 开始的尝试序号
+	Timeout time.Duration // 本次尝试的超时，0 表示不限制
+	Elapsed time.Duration // 从第一次尝试开始经过的时间
+}
+
+// 重试策略
+type Policy struct {
+	// 最大尝试次数，<= 0 时为 len(Timeouts)，Timeouts 也为空时为 1
+	MaxAttempts int
+	// 两次尝试之间的等待时间
+	Delay time.Duration
+	// 每次尝试的超时，超出长度时沿用最后一个，为空时不限制
+	Timeouts []time.Duration
+	// 所有尝试（包括等待）的总时长上限，0 表示不限制
+	Deadline time.Duration
+	// 判断错误是否可以重试，为 nil 时所有错误都会重试
+	Retryable func(err error, a Attempt) bool
+	// 用于计算 Elapsed 和等待 Delay 的时钟，为 nil 时使用系统时间。超时和 Deadline 始终使用系统时间
+	Clock clock.Clock
+}
+
+// 第 n 次（从 1 开始）尝试的超时
+func (p Policy) timeout(n int) time.Duration {
+	if len(p.Timeouts) == 0 {
+		return 0
+	}
+	return p.Timeouts[min(n, len(p.Timeouts))-1]
+}
+
+func (p Policy) maxAttempts() int {
+	switch {
+	case p.MaxAttempts > 0:
+		return p.MaxAttempts
+	case len(p.Timeouts) > 0:
+		return len(p.Timeouts)
+	}
+	return 1
+}
+
+// 按策略执行 f，直到返回 Ok、错误不可重试、次数用尽或超过总时长
+func Do[T any](ctx context.Context, p Policy, f func(context.Context, Attempt) result.Result[T]) result.Result[T] {
+	clk := clock.OrReal(p.Clock)
+	start := clk.Now()
+	if p.Deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Deadline)
+		defer cancel()
+	}
+
+	var last result.Result[T]
+	for n := 1; n <= p.maxAttempts(); n++ {
+		a := Attempt{Number: n, Timeout: p.timeout(n), Elapsed: clk.Now().Sub(start)}
+		last = attempt(ctx, a, f)
+		if last.IsOk() {
+			return last
+		}
+		err := last.GetErr()
+		if ctx.Err() != nil {
+			return result.Err[T](fmt.Errorf("%w after %d attempts: %w", ErrDeadline, n, err))
+		}
+		if p.Retryable != nil && !p.Retryable(err, a) {
+			return last
+		}
+		if n == p.maxAttempts() {
+			break
+		}
+		select {
+		case <-clk.After(p.Delay):
+		case <-ctx.Done():
+			return result.Err[T](fmt.Errorf("%w after %d attempts: %w", ErrDeadline, n, err))
+		}
+	}
+	return last
+}
+
+func attempt[T any](ctx context.Context, a Attempt, f func(context.Context, Attempt) result.Result[T]) result.Result[T] {
+	if a.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.Timeout)
+		defer cancel()
+	}
+	return f(ctx, a)
+}
@@ -0,0 +1,95 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/viocha/go-option/clock/clocktest"
+	"github.com/viocha/go-option/result"
+)
+
+func TestDo_Escalation(t *testing.T) {
+	var seen []time.Duration
+	p := Policy{Timeouts: []time.Duration{5 * time.Millisecond, 10 * time.Millisecond, 50 * time.Millisecond}}
+	r := Do(context.Background(), p, func(ctx context.Context, a Attempt) result.Result[int] {
+		seen = append(seen, a.Timeout)
+		select {
+		case <-time.After(20 * time.Millisecond):
+			return result.Ok(a.Number)
+		case <-ctx.Done():
+			return result.Err[int](ctx.Err())
+		}
+	})
+	if !r.Has(3) {
+		t.Errorf("Expected third attempt to succeed, got %v", r)
+	}
+	if len(seen) != 3 || seen[0] != 5*time.Millisecond || seen[2] != 50*time.Millisecond {
+		t.Errorf("Unexpected timeout schedule: %v", seen)
+	}
+}
+
+func TestDo_Retryable(t *testing.T) {
+	errFatal := errors.New("fatal")
+	calls := 0
+	p := Policy{
+		MaxAttempts: 5,
+		Retryable: func(err error, a Attempt) bool {
+			return !errors.Is(err, errFatal) && a.Number < 2
+		},
+	}
+	r := Do(context.Background(), p, func(ctx context.Context, a Attempt) result.Result[int] {
+		calls++
+		return result.Err[int](errors.New("transient"))
+	})
+	if calls != 2 || r.IsOk() {
+		t.Errorf("Expected classifier to stop after 2 attempts, got %d calls, %v", calls, r)
+	}
+
+	calls = 0
+	Do(context.Background(), p, func(ctx context.Context, a Attempt) result.Result[int] {
+		calls++
+		return result.Err[int](errFatal)
+	})
+	if calls != 1 {
+		t.Errorf("Expected fatal error not to be retried, got %d calls", calls)
+	}
+}
+
+func TestDo_Deadline(t *testing.T) {
+	errVal := errors.New("fail")
+	p := Policy{MaxAttempts: 100, Delay: 5 * time.Millisecond, Deadline: 20 * time.Millisecond}
+	r := Do(context.Background(), p, func(ctx context.Context, a Attempt) result.Result[int] {
+		return result.Err[int](errVal)
+	})
+	if !r.HasErr(ErrDeadline) || !r.HasErr(errVal) {
+		t.Errorf("Expected ErrDeadline wrapping the last error, got %v", r)
+	}
+}
+
+func TestDo_FakeClock(t *testing.T) {
+	clk := clocktest.NewFake(time.Unix(0, 0))
+	p := Policy{MaxAttempts: 3, Delay: time.Hour, Clock: clk}
+	var elapsed []time.Duration
+	done := make(chan result.Result[int])
+	go func() {
+		done <- Do(context.Background(), p, func(_ context.Context, a Attempt) result.Result[int] {
+			elapsed = append(elapsed, a.Elapsed)
+			if a.Number < 3 {
+				return result.Err[int](errors.New("not yet"))
+			}
+			return result.Ok(a.Number)
+		})
+	}()
+	for range 2 {
+		clk.BlockUntil(1)
+		clk.Advance(time.Hour)
+	}
+	if r := <-done; !r.Has(3) {
+		t.Errorf("Expected the third attempt to succeed, got %v", r)
+	}
+	if len(elapsed) != 3 || elapsed[2] != 2*time.Hour {
+		t.Errorf("Expected elapsed time from the fake clock, got %v", elapsed)
+	}
+}
@@ -0,0 +1,104 @@
+package safe
+
+import (
+	"errors"
+	"runtime"
+	"runtime/debug"
+
+	"github.com/viocha/go-option/internal/common"
+	"github.com/viocha/go-option/result"
+)
+
+type config struct {
+	targets         []error
+	repanicRuntime  bool
+	repanicNonError bool
+	onRecover       func(v any, stack []byte)
+}
+
+type CatchOption func(*config)
+
+// 捕获所有 panic（默认行为），会清除之前的 CatchOnly
+func CatchAll() CatchOption {
+	return func(c *config) { c.targets = nil }
+}
+
+// 只捕获 panic 的值为 error 且与 targets 之一匹配（errors.Is）的 panic，其他 panic 原样传播
+func CatchOnly(targets ...error) CatchOption {
+	return func(c *config) { c.targets = append(c.targets, targets...) }
+}
+
+// 不捕获 runtime.Error（空指针解引用、下标越界等），这类 panic 通常意味着程序错误
+func RepanicRuntime() CatchOption {
+	return func(c *config) { c.repanicRuntime = true }
+}
+
+// 不捕获值不是 error 的 panic（如 panic("msg")），只将以 error 为值的 panic 转换为错误
+func RepanicNonError() CatchOption {
+	return func(c *config) { c.repanicNonError = true }
+}
+
+// 捕获到 panic 时以 panic 的值和调用栈调用 f，多次设置时只保留最后一个
+func OnRecover(f func(v any, stack []byte)) CatchOption {
+	return func(c *config) { c.onRecover = f }
+}
+
+// 执行 f 并按 opts 捕获其中的 panic，捕获到的 panic 以 *util.PanicError 的形式返回
+func Do(f func(), opts ...CatchOption) (err error) {
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if !cfg.catches(r) {
+			panic(r)
+		}
+		stack := debug.Stack()
+		if cfg.onRecover != nil {
+			cfg.onRecover(r, stack)
+		}
+		err = common.NewPanicError(r, stack)
+	}()
+	f()
+	return nil
+}
+
+func (c *config) catches(r any) bool {
+	if _, ok := r.(runtime.Error); ok && c.repanicRuntime {
+		return false
+	}
+	err, ok := r.(error)
+	if !ok && c.repanicNonError {
+		return false
+	}
+	if len(c.targets) == 0 {
+		return true
+	}
+	if !ok {
+		return false
+	}
+	for _, target := range c.targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// 执行 f 并按 opts 捕获其中的 panic，捕获到 panic 时返回 Err
+func Call[T any](f func() T, opts ...CatchOption) result.Result[T] {
+	var v T
+	if err := Do(func() { v = f() }, opts...); err != nil {
+		return result.Err[T](err)
+	}
+	return result.Ok(v)
+}
+
+// 只执行操作的函数对应的版本，捕获到 panic 时返回 Err
+func Call0(f func(), opts ...CatchOption) result.Result[struct{}] {
+	return Call(func() struct{} { f(); return struct{}{} }, opts...)
+}
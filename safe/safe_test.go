@@ -0,0 +1,74 @@
+package safe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/viocha/go-option/util"
+)
+
+func mustPanic(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected the panic to propagate")
+		}
+	}()
+	f()
+}
+
+func TestDo(t *testing.T) {
+	err := Do(func() { panic("boom") })
+	var pe *util.PanicError
+	if !errors.As(err, &pe) || pe.Value() != "boom" || len(pe.Stack()) == 0 {
+		t.Errorf("Expected a PanicError, got %v", err)
+	}
+	if err := Do(func() {}); err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+}
+
+func TestDo_Options(t *testing.T) {
+	errKnown := errors.New("known")
+	if err := Do(func() { panic(errKnown) }, CatchOnly(errKnown)); !errors.Is(err, errKnown) {
+		t.Errorf("Expected the listed error to be captured, got %v", err)
+	}
+	mustPanic(t, func() { Do(func() { panic("other") }, CatchOnly(errKnown)) })
+	mustPanic(t, func() {
+		var m map[string]int
+		Do(func() { m["a"] = 1 }, RepanicRuntime())
+	})
+
+	var recovered any
+	Do(func() { panic(42) }, OnRecover(func(v any, stack []byte) { recovered = v }))
+	if recovered != 42 {
+		t.Errorf("Expected the hook to receive the value, got %v", recovered)
+	}
+}
+
+func TestCall(t *testing.T) {
+	if r := Call(func() int { return 1 }); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+	if r := Call(func() int { panic("boom") }); r.IsOk() {
+		t.Errorf("Expected Err, got %v", r)
+	}
+}
+
+func TestRepanicNonError(t *testing.T) {
+	mustPanic(t, func() { Do(func() { panic("msg") }, RepanicNonError()) })
+	errBoom := errors.New("boom")
+	if err := Do(func() { panic(errBoom) }, RepanicNonError()); !errors.Is(err, errBoom) {
+		t.Errorf("Expected error panics to be captured, got %v", err)
+	}
+}
+
+func TestCall0(t *testing.T) {
+	ran := false
+	if r := Call0(func() { ran = true }); !r.IsOk() || !ran {
+		t.Errorf("Expected Ok, got %v", r)
+	}
+	if r := Call0(func() { panic("boom") }); r.IsOk() {
+		t.Errorf("Expected Err, got %v", r)
+	}
+}
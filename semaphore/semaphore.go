@@ -0,0 +1,81 @@
+package semaphore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/viocha/go-option/result"
+)
+
+// 获取许可失败时返回的错误，Cause 为 ctx 的错误（context.Canceled 或 context.DeadlineExceeded）
+type AcquireError struct {
+	Cause error
+}
+
+func (e *AcquireError) Error() string {
+	return fmt.Sprintf("acquire semaphore: %v", e.Cause)
+}
+
+func (e *AcquireError) Unwrap() error {
+	return e.Cause
+}
+
+// 限制并发数量的信号量
+type Semaphore struct {
+	slots chan struct{}
+}
+
+// 创建最多允许 n 个并发持有者的信号量
+func New(n int) *Semaphore {
+	return &Semaphore{slots: make(chan struct{}, n)}
+}
+
+// 已获得的许可，需要调用 Release 归还
+type Permit struct {
+	sem  *Semaphore
+	once sync.Once
+}
+
+// 归还许可，多次调用只会归还一次
+func (p *Permit) Release() {
+	p.once.Do(func() {
+		<-p.sem.slots
+	})
+}
+
+// 获取一个许可，ctx 结束时返回 *AcquireError
+func (s *Semaphore) Acquire(ctx context.Context) result.Result[*Permit] {
+	if err := ctx.Err(); err != nil {
+		return result.Err[*Permit](&AcquireError{Cause: err})
+	}
+	select {
+	case s.slots <- struct{}{}:
+		return result.Ok(&Permit{sem: s})
+	case <-ctx.Done():
+		return result.Err[*Permit](&AcquireError{Cause: ctx.Err()})
+	}
+}
+
+// 尝试立即获取一个许可，没有可用许可时返回 false
+func (s *Semaphore) TryAcquire() (*Permit, bool) {
+	select {
+	case s.slots <- struct{}{}:
+		return &Permit{sem: s}, true
+	default:
+		return nil, false
+	}
+}
+
+// 当前被持有的许可数量
+func (s *Semaphore) InUse() int {
+	return len(s.slots)
+}
+
+// 获取许可后执行 f，f 返回后归还许可
+func WithPermit[T any](ctx context.Context, s *Semaphore, f func(context.Context) result.Result[T]) result.Result[T] {
+	return result.Then(s.Acquire(ctx), func(p *Permit) result.Result[T] {
+		defer p.Release()
+		return f(ctx)
+	})
+}
@@ -0,0 +1,73 @@
+package semaphore
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestAcquireRelease(t *testing.T) {
+	s := New(1)
+	p := s.Acquire(context.Background()).Get()
+	if _, ok := s.TryAcquire(); ok {
+		t.Error("Expected TryAcquire to fail while the permit is held")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	r := s.Acquire(ctx)
+	var acqErr *AcquireError
+	if !r.HasErrAs(&acqErr) || !r.HasErr(context.DeadlineExceeded) {
+		t.Errorf("Expected AcquireError wrapping DeadlineExceeded, got %v", r)
+	}
+
+	p.Release()
+	p.Release()
+	if s.InUse() != 0 {
+		t.Errorf("Expected double Release to release once, got %d in use", s.InUse())
+	}
+}
+
+func TestWithPermit(t *testing.T) {
+	s := New(2)
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			WithPermit(context.Background(), s, func(ctx context.Context) result.Result[int] {
+				n := running.Add(1)
+				for {
+					old := peak.Load()
+					if n <= old || peak.CompareAndSwap(old, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				running.Add(-1)
+				return result.Ok(1)
+			})
+		}()
+	}
+	wg.Wait()
+	if peak.Load() > 2 {
+		t.Errorf("Expected at most 2 concurrent holders, got %d", peak.Load())
+	}
+	if s.InUse() != 0 {
+		t.Errorf("Expected all permits to be released, got %d", s.InUse())
+	}
+
+	errVal := errors.New("err")
+	r := WithPermit(context.Background(), s, func(ctx context.Context) result.Result[int] {
+		return result.Err[int](errVal)
+	})
+	if !r.HasErr(errVal) || s.InUse() != 0 {
+		t.Errorf("Expected Err with permit released, got %v, %d in use", r, s.InUse())
+	}
+}
@@ -0,0 +1,110 @@
+package option
+
+import (
+	"iter"
+	"maps"
+)
+
+// ========================== 集合运算 =============================
+
+// 将 Option 视为包含 0 或 1 个元素的集合：a、b 均存在值且相等时返回 a，否则返回 Nul
+func Intersect[T comparable](a, b Option[T]) Option[T] {
+	if a.IsVal() && b.IsVal() && a.Get() == b.Get() {
+		return a
+	}
+	return Nul[T]()
+}
+
+// 将 Option 视为包含 0 或 1 个元素的集合，返回 a、b 中所有不重复的值（0 到 2 个），a 的值在前
+func Union[T comparable](a, b Option[T]) []T {
+	var vals []T
+	if a.IsVal() {
+		vals = append(vals, a.Get())
+	}
+	if b.IsVal() && !a.Has(b.Get()) {
+		vals = append(vals, b.Get())
+	}
+	return vals
+}
+
+// 将 Option 视为包含 0 或 1 个元素的集合：a 存在值且 b 不包含该值时返回 a，否则返回 Nul
+func Difference[T comparable](a, b Option[T]) Option[T] {
+	if a.IsVal() && !b.Has(a.Get()) {
+		return a
+	}
+	return Nul[T]()
+}
+
+// ========================== Set =============================
+
+// 以 Option 形式读取元素的集合，零值为可以直接使用的空集合。不是并发安全的
+type Set[T comparable] struct {
+	m map[T]struct{}
+}
+
+func NewSet[T comparable]() *Set[T] {
+	return &Set[T]{m: make(map[T]struct{})}
+}
+
+// 以 vals 中的元素创建集合
+func SetOf[T comparable](vals ...T) *Set[T] {
+	s := &Set[T]{m: make(map[T]struct{}, len(vals))}
+	for _, v := range vals {
+		s.m[v] = struct{}{}
+	}
+	return s
+}
+
+// 添加 v，返回 v 之前是否不在集合中
+func (s *Set[T]) Add(v T) bool {
+	if s.Has(v) {
+		return false
+	}
+	if s.m == nil {
+		s.m = make(map[T]struct{})
+	}
+	s.m[v] = struct{}{}
+	return true
+}
+
+// 删除 v，返回 v 之前是否在集合中
+func (s *Set[T]) Remove(v T) bool {
+	if !s.Has(v) {
+		return false
+	}
+	delete(s.m, v)
+	return true
+}
+
+func (s *Set[T]) Has(v T) bool {
+	_, ok := s.m[v]
+	return ok
+}
+
+func (s *Set[T]) Len() int {
+	return len(s.m)
+}
+
+// 删除并返回任意一个元素，集合为空时返回 Nul
+func (s *Set[T]) Pop() Option[T] {
+	for v := range s.m {
+		delete(s.m, v)
+		return Val(v)
+	}
+	return Nul[T]()
+}
+
+// 返回任意一个满足 pred 的元素，不存在时返回 Nul
+func (s *Set[T]) Find(pred func(T) bool) Option[T] {
+	for v := range s.m {
+		if pred(v) {
+			return Val(v)
+		}
+	}
+	return Nul[T]()
+}
+
+// 以 iter.Seq 的形式遍历所有元素，顺序不确定
+func (s *Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s.m)
+}
@@ -0,0 +1,81 @@
+package option
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSetOps(t *testing.T) {
+	if o := Intersect(Val(1), Val(1)); !o.Has(1) {
+		t.Errorf("Expected Val(1), got %v", o)
+	}
+	if o := Intersect(Val(1), Val(2)); o.IsVal() {
+		t.Errorf("Expected Nul, got %v", o)
+	}
+	if o := Intersect(Val(1), Nul[int]()); o.IsVal() {
+		t.Errorf("Expected Nul, got %v", o)
+	}
+
+	tests := []struct {
+		a, b Option[int]
+		want []int
+	}{
+		{Val(1), Val(2), []int{1, 2}},
+		{Val(1), Val(1), []int{1}},
+		{Nul[int](), Val(2), []int{2}},
+		{Nul[int](), Nul[int](), nil},
+	}
+	for _, tt := range tests {
+		if got := Union(tt.a, tt.b); !slices.Equal(got, tt.want) {
+			t.Errorf("Union(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	if o := Difference(Val(1), Val(2)); !o.Has(1) {
+		t.Errorf("Expected Val(1), got %v", o)
+	}
+	if o := Difference(Val(1), Val(1)); o.IsVal() {
+		t.Errorf("Expected Nul, got %v", o)
+	}
+}
+
+func TestSet(t *testing.T) {
+	s := SetOf(1, 2, 3)
+	if s.Add(1) || !s.Add(4) || s.Len() != 4 {
+		t.Errorf("Unexpected Add result, set has %d elements", s.Len())
+	}
+	if !s.Remove(4) || s.Remove(4) || s.Has(4) {
+		t.Error("Unexpected Remove result")
+	}
+	if o := s.Find(func(v int) bool { return v > 2 }); !o.Has(3) {
+		t.Errorf("Expected Val(3), got %v", o)
+	}
+	if o := s.Find(func(v int) bool { return v > 3 }); o.IsVal() {
+		t.Errorf("Expected Nul, got %v", o)
+	}
+
+	var popped []int
+	for o := s.Pop(); o.IsVal(); o = s.Pop() {
+		popped = append(popped, o.Get())
+	}
+	slices.Sort(popped)
+	if !slices.Equal(popped, []int{1, 2, 3}) || s.Len() != 0 {
+		t.Errorf("Expected to pop all elements, got %v", popped)
+	}
+	if o := NewSet[int]().Pop(); o.IsVal() {
+		t.Errorf("Expected Nul from empty set, got %v", o)
+	}
+	if got := slices.Sorted(SetOf("b", "a").All()); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("Unexpected elements: %v", got)
+	}
+}
+
+func TestSet_Zero(t *testing.T) {
+	var s Set[string]
+	if s.Has("a") || s.Remove("a") || s.Pop().IsVal() || s.Len() != 0 {
+		t.Error("Expected the zero Set to be empty")
+	}
+	if !s.Add("a") || !s.Has("a") || s.Len() != 1 {
+		t.Errorf("Expected Add to work on the zero Set, got %d elements", s.Len())
+	}
+}
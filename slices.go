@@ -0,0 +1,75 @@
+package option
+
+// ========================== 切片聚合 =============================
+
+// 将 []Option[T] 收集为 Option[[]T]，任意元素不存在值时返回 Nul
+func Collect[T any](opts []Option[T]) Option[[]T] {
+	vals := make([]T, 0, len(opts))
+	for _, o := range opts {
+		if o.IsNul() {
+			return Nul[[]T]()
+		}
+		vals = append(vals, o.Get())
+	}
+	return Val(vals)
+}
+
+// 返回所有存在的值，丢弃不存在值的元素
+func Values[T any](opts []Option[T]) []T {
+	vals := make([]T, 0, len(opts))
+	for _, o := range opts {
+		if o.IsVal() {
+			vals = append(vals, o.Get())
+		}
+	}
+	return vals
+}
+
+// ========================== 访问 =============================
+
+// 从 map 中获取 key 对应的值，不存在时返回 Nul
+func FromMap[K comparable, V any](m map[K]V, key K) Option[V] {
+	v, ok := m[key]
+	if !ok {
+		return Nul[V]()
+	}
+	return Val(v)
+}
+
+// 获取切片中下标为 i 的元素，越界时返回 Nul
+func Index[T any](s []T, i int) Option[T] {
+	if i < 0 || i >= len(s) {
+		return Nul[T]()
+	}
+	return Val(s[i])
+}
+
+// 获取切片的第一个元素，切片为空时返回 Nul
+func First[T any](s []T) Option[T] {
+	return Index(s, 0)
+}
+
+// 获取切片的最后一个元素，切片为空时返回 Nul
+func Last[T any](s []T) Option[T] {
+	return Index(s, len(s)-1)
+}
+
+// 返回切片中第一个满足条件的元素，不存在时返回 Nul
+func Find[T any](s []T, pred func(T) bool) Option[T] {
+	for _, v := range s {
+		if pred(v) {
+			return Val(v)
+		}
+	}
+	return Nul[T]()
+}
+
+// 所有参数都存在值时返回由这些值组成的切片，与 Collect 相同
+func All[T any](opts ...Option[T]) Option[[]T] {
+	return Collect(opts)
+}
+
+// 返回第一个存在值的 Option，与 Coalesce 相同
+func Any[T any](opts ...Option[T]) Option[T] {
+	return Coalesce(opts...)
+}
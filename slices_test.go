@@ -0,0 +1,83 @@
+package option
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	opt := Collect([]Option[int]{Val(1), Val(2)})
+	if !opt.IsVal() || !slices.Equal(opt.Get(), []int{1, 2}) {
+		t.Errorf("Expected Some([1 2]), got %v", opt)
+	}
+
+	opt2 := Collect([]Option[int]{Val(1), Nul[int]()})
+	if opt2.IsVal() {
+		t.Errorf("Expected None when any element is None, got %v", opt2)
+	}
+}
+
+func TestValues(t *testing.T) {
+	vals := Values([]Option[string]{Val("a"), Nul[string](), Val("b")})
+	if !slices.Equal(vals, []string{"a", "b"}) {
+		t.Errorf("Expected [a b], got %v", vals)
+	}
+
+	if vals := Values[int](nil); len(vals) != 0 {
+		t.Errorf("Expected empty slice, got %v", vals)
+	}
+}
+
+func TestFromMap(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+	if o := FromMap(m, "a"); !o.Has(1) {
+		t.Errorf("Expected Some(1), got %v", o)
+	}
+	if o := FromMap(m, "zero"); !o.Has(0) {
+		t.Errorf("Expected Some(0) for a present zero value, got %v", o)
+	}
+	if o := FromMap(m, "b"); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
+
+func TestIndexFirstLast(t *testing.T) {
+	s := []int{1, 2, 3}
+	if o := Index(s, 1); !o.Has(2) {
+		t.Errorf("Expected Some(2), got %v", o)
+	}
+	if Index(s, 3).IsVal() || Index(s, -1).IsVal() {
+		t.Error("Expected None for out-of-range index")
+	}
+	if o := First(s); !o.Has(1) {
+		t.Errorf("Expected Some(1), got %v", o)
+	}
+	if o := Last(s); !o.Has(3) {
+		t.Errorf("Expected Some(3), got %v", o)
+	}
+	if First[int](nil).IsVal() || Last[int](nil).IsVal() {
+		t.Error("Expected None for empty slice")
+	}
+}
+
+func TestFind(t *testing.T) {
+	s := []int{1, 4, 6}
+	if o := Find(s, func(v int) bool { return v%2 == 0 }); !o.Has(4) {
+		t.Errorf("Expected Some(4), got %v", o)
+	}
+	if o := Find(s, func(v int) bool { return v > 10 }); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
+
+func TestAllAny(t *testing.T) {
+	if o := All(Val(1), Val(2)); !o.IsVal() || !slices.Equal(o.Get(), []int{1, 2}) {
+		t.Errorf("Expected Some([1 2]), got %v", o)
+	}
+	if o := All(Val(1), Nul[int]()); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+	if o := Any(Nul[int](), Val(2), Val(3)); !o.Has(2) {
+		t.Errorf("Expected Some(2), got %v", o)
+	}
+}
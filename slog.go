@@ -0,0 +1,33 @@
+package option
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/viocha/go-option/internal/common"
+)
+
+// ========================== log/slog =============================
+
+// 实现 slog.LogValuer：Nul 输出为 null，Val 输出内部的值，敏感类型的值会被隐藏（参见 util.RedactType）
+func (o Option[T]) LogValue() slog.Value {
+	switch {
+	case o.IsNul():
+		return slog.AnyValue(nil)
+	case common.IsRedacted(o.val):
+		return slog.StringValue(common.RedactedText)
+	}
+	return slog.AnyValue(o.val)
+}
+
+// Nul 时使用 logger 以 Warn 级别记录一条日志并原样返回 o，Val 时不做任何事。logger 为 nil 时使用 slog.Default()
+func (o Option[T]) LogNone(logger *slog.Logger, msg string, attrs ...slog.Attr) Option[T] {
+	if o.IsVal() {
+		return o
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.LogAttrs(context.Background(), slog.LevelWarn, msg, attrs...)
+	return o
+}
@@ -0,0 +1,42 @@
+package option
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/viocha/go-option/util"
+)
+
+type password string
+
+func TestLogValue(t *testing.T) {
+	util.RedactType[password]()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	logger.Info("user", "age", Val(30), "email", Nul[string](), "pw", Val(password("hunter2")))
+
+	out := buf.String()
+	for _, want := range []string{`"age":30`, `"email":null`, `"pw":"[REDACTED]"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s in %s", want, out)
+		}
+	}
+}
+
+func TestLogNone(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	Val(1).LogNone(logger, "missing")
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing logged for Some, got %s", buf.String())
+	}
+	if o := Nul[int]().LogNone(logger, "missing", slog.String("key", "port")); o.IsVal() {
+		t.Errorf("Expected the receiver to be returned, got %v", o)
+	}
+	if out := buf.String(); !strings.Contains(out, "level=WARN msg=missing key=port") {
+		t.Errorf("Expected a warning, got %s", out)
+	}
+}
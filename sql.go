@@ -0,0 +1,65 @@
+package option
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"time"
+)
+
+// ========================== database/sql =============================
+
+// 实现 sql.Scanner，NULL 转换为 Nul，其余值无损地转换为 T 后包装为 Val，无法无损转换时返回错误。
+// 驱动返回的 []byte 会被复制，不会在之后被驱动覆盖
+func (o *Option[T]) Scan(src any) error {
+	if src == nil {
+		o.reset()
+		return nil
+	}
+	if b, ok := src.([]byte); ok {
+		src = bytes.Clone(b)
+	}
+	return o.setAny(src)
+}
+
+// 实现 driver.Valuer，Nul 转换为 NULL
+func (o Option[T]) Value() (driver.Value, error) {
+	if o.IsNul() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.Get())
+}
+
+// ========================== sql.NullXxx 转换 =============================
+
+func FromNull[T any](n sql.Null[T]) Option[T] { return FromOk(n.V, n.Valid) }
+
+func ToNull[T any](o Option[T]) sql.Null[T] {
+	v, ok := o.ToOk()
+	return sql.Null[T]{V: v, Valid: ok}
+}
+
+func FromNullString(n sql.NullString) Option[string] { return FromOk(n.String, n.Valid) }
+func FromNullInt64(n sql.NullInt64) Option[int64]    { return FromOk(n.Int64, n.Valid) }
+func FromNullBool(n sql.NullBool) Option[bool]       { return FromOk(n.Bool, n.Valid) }
+func FromNullTime(n sql.NullTime) Option[time.Time]  { return FromOk(n.Time, n.Valid) }
+
+func ToNullString(o Option[string]) sql.NullString {
+	v, ok := o.ToOk()
+	return sql.NullString{String: v, Valid: ok}
+}
+
+func ToNullInt64(o Option[int64]) sql.NullInt64 {
+	v, ok := o.ToOk()
+	return sql.NullInt64{Int64: v, Valid: ok}
+}
+
+func ToNullBool(o Option[bool]) sql.NullBool {
+	v, ok := o.ToOk()
+	return sql.NullBool{Bool: v, Valid: ok}
+}
+
+func ToNullTime(o Option[time.Time]) sql.NullTime {
+	v, ok := o.ToOk()
+	return sql.NullTime{Time: v, Valid: ok}
+}
@@ -0,0 +1,95 @@
+package option
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestOption_Scan(t *testing.T) {
+	var o Option[string]
+	if err := o.Scan([]byte("abc")); err != nil || !o.Has("abc") {
+		t.Errorf("Expected Some(abc), got %v, err %v", o, err)
+	}
+	if err := o.Scan(nil); err != nil || o.IsVal() {
+		t.Errorf("Expected None after scanning NULL, got %v, err %v", o, err)
+	}
+
+	var n Option[int]
+	if err := n.Scan(int64(5)); err != nil || !n.Has(5) {
+		t.Errorf("Expected Some(5), got %v, err %v", n, err)
+	}
+	if err := n.Scan(struct{}{}); err == nil {
+		t.Error("Expected error when scanning an incompatible value")
+	}
+}
+
+func TestOption_ScanLossless(t *testing.T) {
+	var s Option[string]
+	if err := s.Scan(int64(65)); err == nil {
+		t.Errorf("Expected an error instead of a rune conversion, got %v", s)
+	}
+	var small Option[int8]
+	if err := small.Scan(int64(300)); err == nil {
+		t.Errorf("Expected an overflow error, got %v", small)
+	}
+	var u Option[uint]
+	if err := u.Scan(int64(-1)); err == nil {
+		t.Errorf("Expected an error for a negative value, got %v", u)
+	}
+	var i Option[int]
+	if err := i.Scan(2.5); err == nil {
+		t.Errorf("Expected an error instead of truncation, got %v", i)
+	}
+	var f Option[float64]
+	if err := f.Scan(int64(3)); err != nil || !f.Has(3) {
+		t.Errorf("Expected Val(3), got %v, err %v", f, err)
+	}
+
+	var b Option[[]byte]
+	src := []byte("abc")
+	if err := b.Scan(src); err != nil {
+		t.Fatal(err)
+	}
+	src[0] = 'x'
+	if string(b.Get()) != "abc" {
+		t.Errorf("Expected the scanned bytes to be copied, got %q", b.Get())
+	}
+	if err := b.Scan("def"); err != nil || string(b.Get()) != "def" {
+		t.Errorf("Expected Val(def), got %v, err %v", b, err)
+	}
+}
+
+func TestOption_Value(t *testing.T) {
+	if v, err := Nul[int]().Value(); v != nil || err != nil {
+		t.Errorf("Expected NULL for None, got %v, err %v", v, err)
+	}
+	if v, err := Val(5).Value(); v != int64(5) || err != nil {
+		t.Errorf("Expected int64(5), got %v, err %v", v, err)
+	}
+}
+
+func TestNullConversions(t *testing.T) {
+	if o := FromNullString(sql.NullString{String: "a", Valid: true}); !o.Has("a") {
+		t.Errorf("Expected Some(a), got %v", o)
+	}
+	if o := FromNullInt64(sql.NullInt64{Int64: 3}); o.IsVal() {
+		t.Errorf("Expected None for an invalid NullInt64, got %v", o)
+	}
+	if n := ToNullBool(Val(false)); !n.Valid || n.Bool {
+		t.Errorf("Expected a valid false, got %+v", n)
+	}
+	now := time.Now()
+	if o := FromNullTime(ToNullTime(Val(now))); !o.HasFunc(now.Equal) {
+		t.Errorf("Expected a round trip, got %v", o)
+	}
+	if n := ToNullString(Nul[string]()); n.Valid {
+		t.Errorf("Expected an invalid NullString, got %+v", n)
+	}
+	if n := ToNullInt64(Val[int64](7)); n != (sql.NullInt64{Int64: 7, Valid: true}) {
+		t.Errorf("Expected a valid 7, got %+v", n)
+	}
+	if o := FromNull(ToNull(Val(1.5))); !o.Has(1.5) {
+		t.Errorf("Expected a round trip through sql.Null, got %v", o)
+	}
+}
@@ -0,0 +1,138 @@
+package state
+
+import (
+	"fmt"
+
+	opt "github.com/viocha/go-option"
+	"github.com/viocha/go-option/result"
+)
+
+// Loadable 所处的阶段
+type Status int
+
+const (
+	NotAsked Status = iota // 尚未开始加载
+	Loading                // 正在加载
+	Done                   // 加载完成，结果可能为 Ok 或 Err
+)
+
+func (s Status) String() string {
+	switch s {
+	case NotAsked:
+		return "NotAsked"
+	case Loading:
+		return "Loading"
+	case Done:
+		return "Done"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// 异步加载的数据，处于 NotAsked、Loading 或 Done(Result[T]) 三种状态之一，零值为 NotAsked
+type Loadable[T any] struct {
+	status Status
+	res    result.Result[T]
+}
+
+// ========================== 构造器 =============================
+
+func NewNotAsked[T any]() Loadable[T] {
+	return Loadable[T]{status: NotAsked}
+}
+
+func NewLoading[T any]() Loadable[T] {
+	return Loadable[T]{status: Loading}
+}
+
+func NewDone[T any](r result.Result[T]) Loadable[T] {
+	return Loadable[T]{status: Done, res: r}
+}
+
+// 等价于 NewDone(result.Ok(v))
+func Ok[T any](v T) Loadable[T] {
+	return NewDone(result.Ok(v))
+}
+
+// 等价于 NewDone(result.Err[T](err))
+func Err[T any](err error) Loadable[T] {
+	return NewDone(result.Err[T](err))
+}
+
+// ========================== 方法 =============================
+
+func (l Loadable[T]) Status() Status { return l.status }
+
+func (l Loadable[T]) IsNotAsked() bool { return l.status == NotAsked }
+func (l Loadable[T]) IsLoading() bool  { return l.status == Loading }
+func (l Loadable[T]) IsDone() bool     { return l.status == Done }
+
+func (l Loadable[T]) String() string {
+	if l.IsDone() {
+		return fmt.Sprintf("Done(%v)", l.res)
+	}
+	return l.status.String()
+}
+
+// 加载完成时返回其结果，否则返回 Nul
+func (l Loadable[T]) Result() opt.Option[result.Result[T]] {
+	if !l.IsDone() {
+		return opt.Nul[result.Result[T]]()
+	}
+	return opt.Val(l.res)
+}
+
+// 加载完成且为 Ok 时返回其值，否则返回 Nul
+func (l Loadable[T]) Val() opt.Option[T] {
+	if !l.IsDone() {
+		return opt.Nul[T]()
+	}
+	return l.res.Val()
+}
+
+// 加载完成且为 Err 时返回其错误，否则返回 Nul
+func (l Loadable[T]) Err() opt.Option[error] {
+	if !l.IsDone() {
+		return opt.Nul[error]()
+	}
+	return l.res.Err()
+}
+
+// 转换为 Result，未加载完成时返回 Err(pending)
+func (l Loadable[T]) ToResult(pending error) result.Result[T] {
+	if !l.IsDone() {
+		return result.Err[T](pending)
+	}
+	return l.res
+}
+
+// ========================== 函数 =============================
+
+// 加载完成时使用 result.Map 转换其结果，其他状态保持不变
+func Map[T any, U any](l Loadable[T], f func(T) U) Loadable[U] {
+	if !l.IsDone() {
+		return Loadable[U]{status: l.status}
+	}
+	return NewDone(result.Map(l.res, f))
+}
+
+// 加载完成且为 Ok 时返回 f 的结果，加载完成且为 Err 时保留错误，其他状态保持不变
+func Then[T any, U any](l Loadable[T], f func(T) Loadable[U]) Loadable[U] {
+	if !l.IsDone() {
+		return Loadable[U]{status: l.status}
+	}
+	if l.res.IsErr() {
+		return NewDone(result.Propagate[U](l.res.GetErr()))
+	}
+	return f(l.res.Get())
+}
+
+// 根据状态调用对应的函数，不捕获任何 panic
+func Match[T any, U any](l Loadable[T], notAsked func() U, loading func() U, done func(result.Result[T]) U) U {
+	switch l.status {
+	case NotAsked:
+		return notAsked()
+	case Loading:
+		return loading()
+	}
+	return done(l.res)
+}
@@ -0,0 +1,69 @@
+package state
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/viocha/go-option/result"
+)
+
+func TestLoadable(t *testing.T) {
+	var zero Loadable[int]
+	if !zero.IsNotAsked() || zero.String() != "NotAsked" {
+		t.Errorf("Expected the zero value to be NotAsked, got %v", zero)
+	}
+	if l := NewLoading[int](); !l.IsLoading() || l.Val().IsVal() || l.Result().IsVal() {
+		t.Errorf("Expected Loading without a result, got %v", l)
+	}
+	if l := Ok(3); !l.IsDone() || !l.Val().Has(3) || l.String() != "Done(Ok[int](3))" {
+		t.Errorf("Expected Done(Ok(3)), got %v", l)
+	}
+	errFail := errors.New("fail")
+	if l := Err[int](errFail); !l.Err().Has(errFail) || l.Val().IsVal() {
+		t.Errorf("Expected Done(Err), got %v", l)
+	}
+
+	errPending := errors.New("pending")
+	if r := NewLoading[int]().ToResult(errPending); !r.HasErr(errPending) {
+		t.Errorf("Expected the pending error, got %v", r)
+	}
+	if r := Ok(1).ToResult(errPending); !r.Has(1) {
+		t.Errorf("Expected Ok(1), got %v", r)
+	}
+}
+
+func TestMapThen(t *testing.T) {
+	if l := Map(NewLoading[int](), strconv.Itoa); !l.IsLoading() {
+		t.Errorf("Expected Loading to be kept, got %v", l)
+	}
+	if l := Map(Ok(2), strconv.Itoa); !l.Val().Has("2") {
+		t.Errorf("Expected Done(Ok(2)), got %v", l)
+	}
+	half := func(v int) Loadable[int] {
+		if v%2 != 0 {
+			return Err[int](errors.New("odd"))
+		}
+		return Ok(v / 2)
+	}
+	if l := Then(Ok(4), half); !l.Val().Has(2) {
+		t.Errorf("Expected Done(Ok(2)), got %v", l)
+	}
+	if l := Then(Ok(3), half); l.Err().IsNul() {
+		t.Errorf("Expected Done(Err), got %v", l)
+	}
+	if l := Then(NewNotAsked[int](), half); !l.IsNotAsked() {
+		t.Errorf("Expected NotAsked to be kept, got %v", l)
+	}
+
+	view := func(l Loadable[int]) string {
+		return Match(l, func() string { return "idle" }, func() string { return "spinner" },
+			func(r result.Result[int]) string { return r.String() })
+	}
+	if s := view(NewLoading[int]()); s != "spinner" {
+		t.Errorf("Expected spinner, got %q", s)
+	}
+	if s := view(Ok(1)); s != "Ok[int](1)" {
+		t.Errorf("Expected Ok[int](1), got %q", s)
+	}
+}
@@ -0,0 +1,22 @@
+package option
+
+import "time"
+
+// ========================== 时间 =============================
+
+// 零时间（time.Time{}，IsZero 为 true）视为不存在值。
+// 与 FromNonZero 不同，不受时区和单调时钟读数的影响
+func FromTime(t time.Time) Option[time.Time] {
+	if t.IsZero() {
+		return Nul[time.Time]()
+	}
+	return Val(t)
+}
+
+// 存在值时按 layout 格式化，否则返回 fallback
+func FormatTimeOr(o Option[time.Time], layout, fallback string) string {
+	if o.IsNul() {
+		return fallback
+	}
+	return o.Get().Format(layout)
+}
@@ -0,0 +1,30 @@
+package option
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromTime(t *testing.T) {
+	if o := FromTime(time.Time{}); o.IsVal() {
+		t.Errorf("Expected None for zero time, got %v", o)
+	}
+	// 带时区的零时刻 == time.Time{} 为 false，但仍是零时间
+	if o := FromTime(time.Time{}.In(time.FixedZone("X", 3600))); o.IsVal() {
+		t.Errorf("Expected None for zero time in another zone, got %v", o)
+	}
+	now := time.Now()
+	if o := FromTime(now); !o.IsVal() || !o.Get().Equal(now) {
+		t.Errorf("Expected Some(now), got %v", o)
+	}
+}
+
+func TestFormatTimeOr(t *testing.T) {
+	d := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if s := FormatTimeOr(Val(d), time.DateOnly, "-"); s != "2024-01-02" {
+		t.Errorf("Expected 2024-01-02, got %q", s)
+	}
+	if s := FormatTimeOr(FromTime(time.Time{}), time.DateOnly, "-"); s != "-" {
+		t.Errorf("Expected fallback, got %q", s)
+	}
+}
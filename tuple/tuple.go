@@ -0,0 +1,47 @@
+package tuple
+
+import "fmt"
+
+// 一对值
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// 三个值
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+func NewTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: a, Second: b, Third: c}
+}
+
+// 返回所有值，便于多重赋值
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// 交换两个值的位置
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+// 返回所有值，便于多重赋值
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.First, t.Second, t.Third)
+}
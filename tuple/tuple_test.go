@@ -0,0 +1,23 @@
+package tuple
+
+import "testing"
+
+func TestPair(t *testing.T) {
+	p := NewPair("a", 1)
+	if s, n := p.Unpack(); s != "a" || n != 1 {
+		t.Errorf("Unexpected values %v %v", s, n)
+	}
+	if q := p.Swap(); q != NewPair(1, "a") {
+		t.Errorf("Expected swapped pair, got %v", q)
+	}
+	if p.String() != "(a, 1)" {
+		t.Errorf("Unexpected string %q", p.String())
+	}
+}
+
+func TestTriple(t *testing.T) {
+	tr := NewTriple(1, "b", true)
+	if a, b, c := tr.Unpack(); a != 1 || b != "b" || !c || tr.String() != "(1, b, true)" {
+		t.Errorf("Unexpected triple %v", tr)
+	}
+}
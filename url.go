@@ -0,0 +1,13 @@
+package option
+
+import "net/url"
+
+// ========================== URL 参数 =============================
+
+// 读取查询参数或表单字段的第一个值，key 不存在时返回 Nul；存在但为空字符串时返回 Val("")
+func FromURLValues(v url.Values, key string) Option[string] {
+	if !v.Has(key) {
+		return Nul[string]()
+	}
+	return Val(v.Get(key))
+}
@@ -0,0 +1,19 @@
+package option
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestFromURLValues(t *testing.T) {
+	v := url.Values{"q": {"go", "rust"}, "empty": {""}}
+	if o := FromURLValues(v, "q"); !o.Has("go") {
+		t.Errorf("Expected Some(go), got %v", o)
+	}
+	if o := FromURLValues(v, "empty"); !o.Has("") {
+		t.Errorf("Expected Some(\"\"), got %v", o)
+	}
+	if o := FromURLValues(v, "page"); o.IsVal() {
+		t.Errorf("Expected None, got %v", o)
+	}
+}
@@ -0,0 +1,28 @@
+package util
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Option.Assert/Result.Assert 中断言失败时产生的错误
+type AssertionError struct {
+	Msg   string
+	Value any // 不满足断言的值
+}
+
+func (e *AssertionError) Error() string {
+	return fmt.Sprintf("assertion failed: %s (value: %v)", e.Msg, e.Value)
+}
+
+var debugAssertions atomic.Bool
+
+// 开启后断言失败时直接以 *AssertionError panic，而不是转换为 Err/Nul，返回之前的设置
+func SetDebugAssertions(enabled bool) bool {
+	return debugAssertions.Swap(enabled)
+}
+
+// 是否开启了调试断言
+func DebugAssertions() bool {
+	return debugAssertions.Load()
+}
@@ -0,0 +1,19 @@
+package util
+
+import "github.com/viocha/go-option/internal/hooks"
+
+// 传给观测钩子的事件，包含错误、调用栈和类型信息
+type HookEvent = hooks.Event
+
+// 设置 panic 被链式方法捕获并转换为 Err/Nul 时调用的钩子，返回之前的钩子，f 为 nil 时移除钩子。
+// 可以用于将被静默吞掉的失败上报到监控系统。钩子在捕获 panic 的 goroutine 中同步调用
+func SetPanicHook(f func(HookEvent)) func(HookEvent) {
+	return hooks.Swap(&hooks.Panic, f)
+}
+
+// 设置每次构造 Err 时调用的钩子，返回之前的钩子，f 为 nil 时移除钩子。
+// 只在错误首次进入 Result 时调用（Err、From 等构造函数以及捕获 panic），链式方法转发上游的错误时不会再次调用
+// 设置钩子后每次构造 Err 都会采集调用栈，开销较大
+func SetErrHook(f func(HookEvent)) func(HookEvent) {
+	return hooks.Swap(&hooks.Err, f)
+}
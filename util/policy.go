@@ -0,0 +1,28 @@
+package util
+
+import (
+	"sync/atomic"
+)
+
+// 链式方法（FromFunc/Try/Then/Map 等）对回调中 ErrMust panic 的处理策略
+type PanicPolicy int32
+
+const (
+	// 捕获 ErrMust panic：Result 转换为 Err，Option 转换为 Nul。默认策略
+	CapturePanics PanicPolicy = iota
+	// 不捕获任何 panic，回调中的 panic 原样向上传播
+	PropagatePanics
+)
+
+var panicPolicy atomic.Int32
+
+// 设置全局的 panic 处理策略，返回之前的策略。策略对整个进程生效，库代码不应调用；
+// 需要在单次调用中捕获任意 panic 时使用 option.TryMap/TryThen 或 result.TryMap/TryThen
+func SetPanicPolicy(p PanicPolicy) PanicPolicy {
+	return PanicPolicy(panicPolicy.Swap(int32(p)))
+}
+
+// 当前的 panic 处理策略
+func GetPanicPolicy() PanicPolicy {
+	return PanicPolicy(panicPolicy.Load())
+}
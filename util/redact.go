@@ -0,0 +1,12 @@
+package util
+
+import (
+	"reflect"
+
+	"github.com/viocha/go-option/internal/common"
+)
+
+// 将 T 标记为敏感类型，Option/Result 输出结构化日志（slog.LogValuer）时会隐藏该类型的值
+func RedactType[T any]() {
+	common.Redact(reflect.TypeFor[T]())
+}
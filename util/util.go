@@ -3,6 +3,8 @@ package util
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"runtime"
 	
 	"github.com/viocha/go-option/internal/common"
 )
@@ -49,6 +51,60 @@ func MustGet2[T1, T2 any](v1 T1, v2 T2, err error) (T1, T2) {
 	return v1, v2
 }
 
+// 强制获取三个值，如果有错误则 panic
+func MustGet3[T1, T2, T3 any](v1 T1, v2 T2, v3 T3, err error) (T1, T2, T3) {
+	mustNil(err, "panic in MustGet3")
+	return v1, v2, v3
+}
+
+// 强制获取四个值，如果有错误则 panic
+func MustGet4[T1, T2, T3, T4 any](v1 T1, v2 T2, v3 T3, v4 T4, err error) (T1, T2, T3, T4) {
+	mustNil(err, "panic in MustGet4")
+	return v1, v2, v3, v4
+}
+
+// 只返回 error 的函数对应的版本，与 MustNil 相同
+func Must0(err error) {
+	mustNil(err, "panic in Must0")
+}
+
+// 与 MustNil 相同，但错误消息中会包含调用处的 file:line
+func MustNilAt(err error) {
+	mustNil(atCaller(err), "panic in MustNilAt")
+}
+
+// 与 MustGet 相同，但错误消息中会包含调用处的 file:line
+func MustGetAt[T any](v T, err error) T {
+	mustNil(atCaller(err), "panic in MustGetAt")
+	return v
+}
+
+// 与 MustGet2 相同，但错误消息中会包含调用处的 file:line
+func MustGet2At[T1, T2 any](v1 T1, v2 T2, err error) (T1, T2) {
+	mustNil(atCaller(err), "panic in MustGet2At")
+	return v1, v2
+}
+
+func mustNil(err error, msg string) {
+	if err != nil {
+		if !errors.Is(err, ErrMust) {
+			err = common.WrapSub(err, ErrMust, "%s", msg)
+		}
+		panic(err)
+	}
+}
+
+// 在错误前加上调用 atCaller 的函数的调用处
+func atCaller(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, file, line, ok := runtime.Caller(2); ok {
+		return fmt.Errorf("%s:%d: %w", filepath.Base(file), line, err)
+	}
+	return err
+}
+
 // 捕获所有 panic，如果存在，则转换成 ErrMust 错误，然后panic
 func WrapPanic(f func()) {
 	err := common.SafeDo(f)
@@ -74,3 +130,6 @@ func WrapPanicGet[T any](f func() T) T {
 		return result // 如果没有错误，返回结果
 	}
 }
+
+// 由 panic 转换而来的错误，可以通过 errors.As 从 Err 中取出，并通过 Value() 和 Stack() 获取 panic 的值和调用栈
+type PanicError = common.PanicError
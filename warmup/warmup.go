@@ -0,0 +1,111 @@
+package warmup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/viocha/go-option/internal/must"
+	"github.com/viocha/go-option/result"
+)
+
+// 默认的预热并发数
+const DefaultConcurrency = 4
+
+// 可以被提前求值的对象，例如 Lazy 或 Future 的值
+type Warmable interface {
+	Warm(ctx context.Context) error
+}
+
+type funcWarmable struct {
+	name string
+	f    func(context.Context) error
+}
+
+func (w funcWarmable) Warm(ctx context.Context) error { return w.f(ctx) }
+func (w funcWarmable) String() string                 { return w.name }
+
+// 将函数包装为具名的 Warmable
+func Func(name string, f func(context.Context) error) Warmable {
+	return funcWarmable{name: name, f: f}
+}
+
+// 单个对象的预热结果
+type Item struct {
+	Name     string
+	Err      error
+	Duration time.Duration
+}
+
+// 预热报告，Items 与传入的顺序一致
+type Report struct {
+	Items []Item
+}
+
+// 预热失败的对象
+func (r Report) Failed() []Item {
+	var failed []Item
+	for _, it := range r.Items {
+		if it.Err != nil {
+			failed = append(failed, it)
+		}
+	}
+	return failed
+}
+
+// 存在预热失败的对象时返回的错误，可以通过 errors.As 取出完整的报告
+type Error struct {
+	Report Report
+}
+
+func (e *Error) Error() string {
+	var names []string
+	for _, it := range e.Report.Failed() {
+		names = append(names, fmt.Sprintf("%s: %v", it.Name, it.Err))
+	}
+	return "warm-up failed: " + strings.Join(names, "; ")
+}
+
+// 以 DefaultConcurrency 的并发数预热所有对象
+func Prefetch(ctx context.Context, items ...Warmable) result.Result[Report] {
+	return PrefetchN(ctx, DefaultConcurrency, items...)
+}
+
+// 以最多 n 个并发预热所有对象，全部成功时返回 Ok(报告)，否则返回包含报告的 *Error。
+// 实现了 fmt.Stringer 的对象以其字符串作为名称，否则使用其序号
+func PrefetchN(ctx context.Context, n int, items ...Warmable) result.Result[Report] {
+	report := Report{Items: make([]Item, len(items))}
+	slots := make(chan struct{}, max(n, 1))
+	var wg sync.WaitGroup
+	for i, w := range items {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			start := time.Now()
+			err := ctx.Err()
+			if err == nil {
+				if panicErr := must.CatchMustPanic(func() { err = w.Warm(ctx) }); panicErr != nil {
+					err = panicErr
+				}
+			}
+			report.Items[i] = Item{Name: itemName(i, w), Err: err, Duration: time.Since(start)}
+		}()
+	}
+	wg.Wait()
+	if len(report.Failed()) > 0 {
+		return result.Err[Report](&Error{Report: report})
+	}
+	return result.Ok(report)
+}
+
+func itemName(i int, w Warmable) string {
+	if s, ok := w.(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprintf("#%d", i)
+}
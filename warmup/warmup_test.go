@@ -0,0 +1,45 @@
+package warmup
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type counter struct{ warmed bool }
+
+func (c *counter) Warm(context.Context) error {
+	c.warmed = true
+	return nil
+}
+
+func TestPrefetch(t *testing.T) {
+	c := &counter{}
+	r := Prefetch(context.Background(), c, Func("config", func(context.Context) error { return nil }))
+	if !r.IsOk() || !c.warmed {
+		t.Fatalf("Expected Ok with all items warmed, got %v", r)
+	}
+	items := r.Get().Items
+	if items[0].Name != "#0" || items[1].Name != "config" {
+		t.Errorf("Unexpected item names: %+v", items)
+	}
+}
+
+func TestPrefetch_Failure(t *testing.T) {
+	errDown := errors.New("db down")
+	r := PrefetchN(context.Background(), 1,
+		Func("db", func(context.Context) error { return errDown }),
+		Func("cache", func(context.Context) error { return nil }),
+	)
+	var werr *Error
+	if !r.HasErrAs(&werr) {
+		t.Fatalf("Expected *Error, got %v", r)
+	}
+	failed := werr.Report.Failed()
+	if len(failed) != 1 || failed[0].Name != "db" || !errors.Is(failed[0].Err, errDown) {
+		t.Errorf("Unexpected failed items: %+v", failed)
+	}
+	if len(werr.Report.Items) != 2 || werr.Report.Items[1].Err != nil {
+		t.Errorf("Expected the cache item to succeed, got %+v", werr.Report.Items)
+	}
+}